@@ -1,11 +1,19 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand"
+	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -22,12 +30,16 @@ import (
 type App struct {
 	ctx                   context.Context
 	db                    *database.DB
-	client                *store.Client
+	client                store.PagerDutyClient
+	clientMu              sync.Mutex // serializes client swaps so an old client is shut down once
 	polling               bool
 	pollTicker            *time.Ticker
+	pollStop              chan struct{}
 	servicesConfig        *store.ServicesConfig
 	selectedServices      []string
+	teamFilter            []string // team IDs incidents are limited to; empty means any team
 	kr                    keyring.Keyring
+	keyringBackend        string
 	logger                *Logger
 	filterByUser          bool
 	mu                    sync.RWMutex
@@ -36,25 +48,54 @@ type App struct {
 	lastIncidents         map[string]string
 	lastIncidentsMu       sync.RWMutex
 	resolvedPollTicker    *time.Ticker
+	resolvedPollStop      chan struct{}
 	resolvedPolling       bool
 	resolvedPollMu        sync.RWMutex
 	rateLimitTracker      *RateLimitTracker
 	userCache             *UserCache
 	lastResolvedFetch     time.Time
 	lastResolvedFetchMu   sync.RWMutex
+	lastSuccessfulFetch   time.Time
+	lastSuccessfulFetchMu sync.RWMutex
 	circuitBreaker        *CircuitBreaker
+	connectionStatus      string // last status emitted in connection-status-changed; guarded by connectionStatusMu
+	connectionStatusMu    sync.Mutex
 	previousOpenIncidents map[string]database.IncidentData
+	previousOpenSeeded    bool
+	alertCountBaseline    map[string]int // open incident ID -> alert count last notified on; guarded by previousOpenMu
+	alertGrowthDelta      int32          // atomic; alert count increase that notifies, 0 disables
+	stormThreshold        int32
+	resolvedDisplayMax    int32 // atomic; max resolved incidents returned to the UI
+	slaThresholdMinutes   int32 // atomic; open incidents older than this are flagged as breaching
+	resyncMu              sync.Mutex
 	previousOpenMu        sync.RWMutex
+	pendingResolves       map[string]pendingResolve // user's incidents that left the open list, until PagerDuty confirms the resolve; guarded by previousOpenMu
 	shutdownChan          chan struct{}
 	shutdownWg            sync.WaitGroup
 	userPolling           bool
 	userPollTicker        *time.Ticker
+	userPollStop          chan struct{}
 	userPollMu            sync.RWMutex
+	paused                int32 // atomic; 1 while polling and notifications are paused
+	monitorAll            int32 // atomic; 1 when incidents are fetched for every service
+	demoActive            int32 // atomic; 1 while the mock client is in use, which implies monitorAll
 	latestResolvedDate    time.Time
 	latestResolvedMu      sync.RWMutex
 	resolvedFetchMu       sync.Mutex
 	sidebarFetchingMu     sync.Mutex
 	fetchingIncidents     map[string]bool
+	sidebarSlots          chan struct{} // caps concurrent sidebar API fetches; guarded by sidebarFetchingMu
+	serviceInterval       time.Duration
+	userInterval          time.Duration
+	resolvedInterval      time.Duration
+	resolvedLookback      time.Duration
+	intervalsMu           sync.RWMutex
+	onCallCache           *OnCallCache
+	userDirectory         *UserDirectoryCache
+	webhookServer         *http.Server
+	webhookMu             sync.Mutex
+	webhookExposed        int32 // atomic; 1 while the receiver listens beyond loopback and so requires a signing secret
+	webhookActive         bool  // guarded by intervalsMu; slows polling while webhooks arrive
 }
 
 // RateLimitTracker
@@ -73,6 +114,28 @@ type UserCache struct {
 	mu        sync.RWMutex
 }
 
+// OnCallCache holds on-call lookups per service for a short time so repeated
+// lookups during polling don't hit the API
+type OnCallCache struct {
+	entries map[string]onCallCacheEntry
+	ttl     time.Duration
+	mu      sync.RWMutex
+}
+
+type onCallCacheEntry struct {
+	onCalls   []store.OnCallEntry
+	expiresAt time.Time
+}
+
+// UserDirectoryCache holds the account's user list for a few minutes; it
+// changes rarely and backs typeahead searches that fire on every keystroke
+type UserDirectoryCache struct {
+	users     []store.UserSummary
+	expiresAt time.Time
+	ttl       time.Duration
+	mu        sync.RWMutex
+}
+
 type CircuitBreaker struct {
 	failures          int32
 	lastFailure       time.Time
@@ -81,9 +144,63 @@ type CircuitBreaker struct {
 	cooldownPeriod    time.Duration
 	backoffMultiplier float64
 	currentBackoff    time.Duration
+	maxBackoff        time.Duration
 	mu                sync.RWMutex
+	onStateChange     func(state string)
+}
+
+// CircuitBreakerConfig is the user-tunable part of the circuit breaker
+type CircuitBreakerConfig struct {
+	MaxFailures       int `json:"max_failures"`
+	CooldownSeconds   int `json:"cooldown_seconds"`
+	MaxBackoffSeconds int `json:"max_backoff_seconds"`
 }
 
+// Default circuit breaker settings, used until SetCircuitBreakerConfig overrides them
+const (
+	defaultBreakerMaxFailures = 5
+	defaultBreakerCooldown    = 30 * time.Second
+	defaultBreakerMaxBackoff  = 5 * time.Minute
+)
+
+// PagerDuty's REST API allows 960 calls per minute; SetRateLimitConfig accepts
+// thresholds between minRateLimit and that ceiling.
+const (
+	pagerDutyRateLimit = 960
+	minRateLimit       = 60
+)
+
+// Bounds in seconds for the API request timeouts accepted by SetAPITimeouts
+const (
+	minAPITimeoutSeconds = 10
+	maxAPITimeoutSeconds = 900
+)
+
+// Bounds for how many open incidents a poll fetches per service or user query
+const (
+	minMaxOpenResults = 25
+	maxMaxOpenResults = 1000
+)
+
+// Default polling intervals and the minimums enforced by SetPollingIntervals
+// to keep polling within the PagerDuty rate limit.
+const (
+	defaultServiceInterval  = 3 * time.Second
+	defaultUserInterval     = 4 * time.Second
+	defaultResolvedInterval = 1 * time.Minute
+	minServiceInterval      = 2 * time.Second
+	minUserInterval         = 2 * time.Second
+	minResolvedInterval     = 30 * time.Second
+)
+
+// How far back resolved incidents are fetched, in hours. The configured value
+// also caps every later resolved fetch to keep large backfills fast.
+const (
+	defaultResolvedLookbackHours = 72
+	minResolvedLookbackHours     = 1
+	maxResolvedLookbackHours     = 14 * 24
+)
+
 func NewRateLimitTracker() *RateLimitTracker {
 	return &RateLimitTracker{
 		windowSize: time.Minute,
@@ -98,12 +215,48 @@ func NewUserCache() *UserCache {
 	}
 }
 
+func NewOnCallCache() *OnCallCache {
+	return &OnCallCache{
+		entries: make(map[string]onCallCacheEntry),
+		ttl:     2 * time.Minute,
+	}
+}
+
+func NewUserDirectoryCache() *UserDirectoryCache {
+	return &UserDirectoryCache{
+		ttl: 5 * time.Minute,
+	}
+}
+
 func NewCircuitBreaker() *CircuitBreaker {
 	return &CircuitBreaker{
-		maxFailures:       5,
-		cooldownPeriod:    30 * time.Second,
+		maxFailures:       defaultBreakerMaxFailures,
+		cooldownPeriod:    defaultBreakerCooldown,
 		backoffMultiplier: 2.0,
-		currentBackoff:    30 * time.Second,
+		currentBackoff:    defaultBreakerCooldown,
+		maxBackoff:        defaultBreakerMaxBackoff,
+	}
+}
+
+// Configure updates the failure threshold and backoff bounds. The current
+// backoff restarts from the new cooldown.
+func (cb *CircuitBreaker) Configure(maxFailures int, cooldown, maxBackoff time.Duration) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.maxFailures = int32(maxFailures)
+	cb.cooldownPeriod = cooldown
+	cb.maxBackoff = maxBackoff
+	cb.currentBackoff = cooldown
+}
+
+// Config returns the breaker's current settings
+func (cb *CircuitBreaker) Config() CircuitBreakerConfig {
+	cb.mu.RLock()
+	defer cb.mu.RUnlock()
+	return CircuitBreakerConfig{
+		MaxFailures:       int(cb.maxFailures),
+		CooldownSeconds:   int(cb.cooldownPeriod / time.Second),
+		MaxBackoffSeconds: int(cb.maxBackoff / time.Second),
 	}
 }
 
@@ -127,6 +280,20 @@ func (r *RateLimitTracker) CanMakeCall() bool {
 	return len(r.calls) < r.maxCalls
 }
 
+// SetMaxCalls updates the per-minute call threshold
+func (r *RateLimitTracker) SetMaxCalls(maxCalls int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.maxCalls = maxCalls
+}
+
+// MaxCalls returns the per-minute call threshold
+func (r *RateLimitTracker) MaxCalls() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.maxCalls
+}
+
 func (r *RateLimitTracker) RecordCall() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -164,7 +331,7 @@ func (cb *CircuitBreaker) Allow() bool {
 
 		if time.Since(lastFailure) > currentBackoff {
 			// Try half-open state
-			atomic.StoreInt32(&cb.state, 2)
+			cb.setState(2)
 			return true
 		}
 		return false
@@ -175,7 +342,7 @@ func (cb *CircuitBreaker) Allow() bool {
 
 func (cb *CircuitBreaker) RecordSuccess() {
 	atomic.StoreInt32(&cb.failures, 0)
-	atomic.StoreInt32(&cb.state, 0) // Closed
+	cb.setState(0) // Closed
 
 	// Reset backoff on success
 	cb.mu.Lock()
@@ -190,16 +357,54 @@ func (cb *CircuitBreaker) RecordFailure() {
 	cb.lastFailure = time.Now()
 
 	// Exponential backoff: double the backoff period on each failure
-	if failures >= cb.maxFailures {
-		atomic.StoreInt32(&cb.state, 1) // Open
-
-		// Increase backoff exponentially, cap at 5 minutes
+	tripped := failures >= cb.maxFailures
+	if tripped {
+		// Increase backoff exponentially, up to the configured cap
 		cb.currentBackoff = time.Duration(float64(cb.currentBackoff) * cb.backoffMultiplier)
-		if cb.currentBackoff > 5*time.Minute {
-			cb.currentBackoff = 5 * time.Minute
+		if cb.currentBackoff > cb.maxBackoff {
+			cb.currentBackoff = cb.maxBackoff
 		}
 	}
 	cb.mu.Unlock()
+
+	if tripped {
+		cb.setState(1) // Open
+	}
+}
+
+// SetStateChangeHandler registers a function called with the new state name
+// ("closed", "open" or "half-open") whenever the breaker changes state
+func (cb *CircuitBreaker) SetStateChangeHandler(handler func(state string)) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.onStateChange = handler
+}
+
+// setState stores the new state and notifies the handler if it changed
+func (cb *CircuitBreaker) setState(state int32) {
+	if atomic.SwapInt32(&cb.state, state) == state {
+		return
+	}
+
+	cb.mu.RLock()
+	handler := cb.onStateChange
+	cb.mu.RUnlock()
+
+	if handler != nil {
+		handler(circuitStateName(state))
+	}
+}
+
+// circuitStateName returns the name used for a breaker state in UI events
+func circuitStateName(state int32) string {
+	switch state {
+	case 0:
+		return "closed"
+	case 1:
+		return "open"
+	default:
+		return "half-open"
+	}
 }
 
 func (uc *UserCache) Get() (string, bool) {
@@ -244,14 +449,79 @@ func (uc *UserCache) Invalidate() {
 	uc.expiresAt = time.Time{}
 }
 
+func (oc *OnCallCache) Get(serviceID string) ([]store.OnCallEntry, bool) {
+	oc.mu.RLock()
+	defer oc.mu.RUnlock()
+
+	entry, ok := oc.entries[serviceID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.onCalls, true
+}
+
+func (oc *OnCallCache) Set(serviceID string, onCalls []store.OnCallEntry) {
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+
+	oc.entries[serviceID] = onCallCacheEntry{
+		onCalls:   onCalls,
+		expiresAt: time.Now().Add(oc.ttl),
+	}
+}
+
+func (oc *OnCallCache) Invalidate() {
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+
+	oc.entries = make(map[string]onCallCacheEntry)
+}
+
+func (uc *UserDirectoryCache) Get() ([]store.UserSummary, bool) {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+
+	if uc.users == nil || time.Now().After(uc.expiresAt) {
+		return nil, false
+	}
+	return uc.users, true
+}
+
+func (uc *UserDirectoryCache) Set(users []store.UserSummary) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	uc.users = users
+	uc.expiresAt = time.Now().Add(uc.ttl)
+}
+
+func (uc *UserDirectoryCache) Invalidate() {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	uc.users = nil
+	uc.expiresAt = time.Time{}
+}
+
 func NewApp() *App {
 	return &App{
 		filterByUser:          true,
 		lastIncidents:         make(map[string]string),
 		previousOpenIncidents: make(map[string]database.IncidentData),
 		shutdownChan:          make(chan struct{}),
-		latestResolvedDate:    time.Now().Add(-72 * time.Hour), // Initialize to 3 days ago
+		latestResolvedDate:    time.Now().Add(-defaultResolvedLookbackHours * time.Hour),
 		fetchingIncidents:     make(map[string]bool),
+		serviceInterval:       defaultServiceInterval,
+		userInterval:          defaultUserInterval,
+		resolvedInterval:      defaultResolvedInterval,
+		resolvedLookback:      defaultResolvedLookbackHours * time.Hour,
+		stormThreshold:        defaultStormThreshold,
+		resolvedDisplayMax:    defaultResolvedDisplayLimit,
+		slaThresholdMinutes:   defaultSLAThresholdMinutes,
+		alertCountBaseline:    make(map[string]int),
+		pendingResolves:       make(map[string]pendingResolve),
+		alertGrowthDelta:      defaultAlertGrowthDelta,
+		sidebarSlots:          make(chan struct{}, defaultSidebarConcurrency),
 	}
 }
 
@@ -296,61 +566,183 @@ func (a *App) startup(
 	}
 	a.db = db
 	a.logger.Info("Database initialized successfully")
+	for _, applied := range db.AppliedMigrations() {
+		a.logger.Info(fmt.Sprintf("Applied database migration %s", applied))
+	}
 
 	// Initialize state table for persistence
 	if err := a.db.InitStateTable(); err != nil {
 		a.logger.Warn(fmt.Sprintf("Failed to initialize state table: %v", err))
 	}
 
-	// Load latest resolved date from database
-	if timestamp, err := a.db.GetState("latest_resolved_date"); err == nil && timestamp != "" {
-		if t, err := time.Parse(time.RFC3339, timestamp); err == nil {
-			a.latestResolvedMu.Lock()
-			a.latestResolvedDate = t
-			a.latestResolvedMu.Unlock()
-			a.logger.Info(fmt.Sprintf("Restored latest resolved date: %s", timestamp))
+	// Restore the saved log level
+	if value, err := a.db.GetState("log_level"); err == nil && value != "" && a.logger != nil {
+		if level, err := ParseLogLevel(value); err == nil {
+			a.logger.SetLogLevel(level)
+			a.logger.Info(fmt.Sprintf("Log level restored: %s", level))
 		}
 	}
+	if seconds, err := a.db.GetStateInt("log_dedup_window"); err == nil && seconds >= 0 && seconds <= maxLogDedupWindowSeconds && a.logger != nil {
+		a.logger.SetDedupWindow(time.Duration(seconds) * time.Second)
+	}
 
-	// Clear old incidents from database on startup to ensure fresh data
-	if err := a.db.ClearIncidents(); err != nil {
+	// Load latest resolved date from database
+	if t, err := a.db.GetStateTime("latest_resolved_date"); err == nil {
+		a.latestResolvedMu.Lock()
+		a.latestResolvedDate = t
+		a.latestResolvedMu.Unlock()
+		a.logger.Info(fmt.Sprintf("Restored latest resolved date: %s", t.Format(time.RFC3339)))
+	}
+
+	// Clear old incidents from database on startup to ensure fresh data,
+	// keeping the user's watched incidents
+	if err := a.db.ClearUnwatchedIncidents(); err != nil {
 		a.logger.Warn(fmt.Sprintf("Failed to clear old incidents: %v", err))
 	}
 
-	// Initialize keyring
-	kr, err := keyring.Open(keyring.Config{
-		ServiceName: "PagerOps",
-	})
+	// Drop sidebar data left behind by the wipe
+	if removed, err := a.db.CleanupOrphanedSidebarData(); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to clean up orphaned sidebar data: %v", err))
+	} else if removed > 0 {
+		a.logger.Info(fmt.Sprintf("Removed %d orphaned sidebar rows", removed))
+	}
+
+	// Initialize keyring, falling back to an encrypted file when no OS
+	// keyring is available (e.g. Linux without Secret Service)
+	kr, backend, err := a.openKeyring(dataDir)
 	if err != nil {
 		a.logger.Warn(fmt.Sprintf("Failed to initialize keyring: %v", err))
 		runtime.LogWarning(ctx, fmt.Sprintf("Failed to initialize keyring: %v", err))
 	} else {
 		a.kr = kr
-		a.logger.Info("Keyring initialized successfully")
+		a.keyringBackend = backend
+		a.logger.Info(fmt.Sprintf("Keyring initialized successfully (backend: %s)", backend))
+		a.migrateLegacyAPIKey()
+		a.migrateWebhookSecret()
 	}
 
 	a.notificationMgr = NewNotificationManager(a.logger)
+	a.notificationMgr.SetAckCallback(func(incidentID string) {
+		if err := a.AcknowledgeIncident(incidentID); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to acknowledge incident %s from notification: %v", incidentID, err))
+		}
+	})
 	a.logger.Info("Notification manager initialized")
 
+	// Restore per-service notification sounds
+	if a.db != nil {
+		if value, err := a.db.GetState("service_sounds"); err == nil && value != "" {
+			var sounds map[string]string
+			if err := json.Unmarshal([]byte(value), &sounds); err == nil {
+				a.notificationMgr.LoadServiceSounds(sounds)
+			} else {
+				a.logger.Warn(fmt.Sprintf("Failed to parse saved service sounds: %v", err))
+			}
+		}
+	}
+
+	// Restore the pause switch before any polling starts
+	if a.db != nil {
+		if paused, err := a.db.GetStateBool("paused"); err == nil && paused {
+			atomic.StoreInt32(&a.paused, 1)
+			a.logger.Info("Polling and notifications are paused from saved settings")
+		}
+	}
+
+	// Restore "all services" mode before any polling starts
+	if a.db != nil {
+		if all, err := a.db.GetStateBool("monitor_all_services"); err == nil && all {
+			atomic.StoreInt32(&a.monitorAll, 1)
+			a.logger.Info("Monitoring all services from saved settings")
+		}
+	}
+
+	// Restore the resolved incidents display limit
+	if a.db != nil {
+		if n, err := a.db.GetStateInt("resolved_display_limit"); err == nil && n >= minResolvedDisplayLimit && n <= maxResolvedDisplayLimit {
+			atomic.StoreInt32(&a.resolvedDisplayMax, int32(n))
+		}
+	}
+
+	// Restore the sidebar fetch concurrency limit
+	if a.db != nil {
+		if n, err := a.db.GetStateInt("sidebar_concurrency"); err == nil && n >= minSidebarConcurrency && n <= maxSidebarConcurrency {
+			a.sidebarFetchingMu.Lock()
+			a.sidebarSlots = make(chan struct{}, n)
+			a.sidebarFetchingMu.Unlock()
+		}
+	}
+
+	// Restore the SLA threshold
+	if a.db != nil {
+		if n, err := a.db.GetStateInt("sla_threshold_minutes"); err == nil && n >= minSLAThresholdMinutes && n <= maxSLAThresholdMinutes {
+			atomic.StoreInt32(&a.slaThresholdMinutes, int32(n))
+		}
+	}
+
+	// Restore the alert growth delta
+	if a.db != nil {
+		if n, err := a.db.GetStateInt("alert_growth_delta"); err == nil && n >= 0 {
+			atomic.StoreInt32(&a.alertGrowthDelta, int32(n))
+		}
+	}
+
+	// Restore the incident storm threshold
+	if a.db != nil {
+		if n, err := a.db.GetStateInt("storm_threshold"); err == nil && n >= 1 {
+			atomic.StoreInt32(&a.stormThreshold, int32(n))
+		}
+	}
+
 	// Initialize incident persistence tracking
 
-	// Load browser redirect setting from database
+	// Restore the notification config, falling back to the older
+	// browser_redirect-only setting
 	if a.db != nil {
-		if value, err := a.db.GetState("browser_redirect"); err == nil {
-			if value == "true" && a.notificationMgr != nil {
+		if value, err := a.db.GetState("notification_config"); err == nil && value != "" {
+			var cfg NotificationConfig
+			if err := json.Unmarshal([]byte(value), &cfg); err == nil {
+				a.notificationMgr.LoadConfig(cfg)
+			} else {
+				a.logger.Warn(fmt.Sprintf("Failed to parse saved notification config: %v", err))
+			}
+		} else if enabled, err := a.db.GetStateBool("browser_redirect"); err == nil {
+			if enabled && a.notificationMgr != nil {
 				a.notificationMgr.SetBrowserRedirect(true)
 				a.logger.Info("Browser redirect enabled from saved settings")
 			}
 		}
 	}
 
+	// Restore the services config and selection from the last session
+	if a.db != nil {
+		a.restoreServicesConfig()
+		a.restoreTeamFilter()
+	}
+
+	// Restore saved polling intervals before any polling starts
+	a.loadPollingIntervals()
+	a.loadResolvedLookback()
+
 	// Initialize production components
 	a.rateLimitTracker = NewRateLimitTracker()
+	if maxCalls := a.savedRateLimit(); maxCalls > 0 {
+		a.rateLimitTracker.SetMaxCalls(maxCalls)
+	}
 	a.userCache = NewUserCache()
-	a.circuitBreaker = NewCircuitBreaker()
+	a.initCircuitBreaker()
+	a.onCallCache = NewOnCallCache()
+	a.userDirectory = NewUserDirectoryCache()
 
 	// Start sidebar data cleanup routine
 	go a.cleanupOldSidebarData()
+	go a.retryPendingActions()
+
+	// Demo mode uses synthetic data instead of the PagerDuty API
+	if a.isDemoMode() {
+		a.startDemoClient()
+		return
+	}
 
 	// In the startup method, modify the section where API key is loaded:
 	// Try to load API key and initialize client
@@ -358,7 +750,12 @@ func (a *App) startup(
 	if err == nil && apiKey != "" {
 		client, err := store.NewClient(apiKey)
 		if err == nil {
-			a.client = client
+			if maxCalls := a.savedRateLimit(); maxCalls > 0 {
+				client.SetMaxCallsPerMinute(maxCalls)
+			}
+			a.applySavedAPITimeouts(client)
+			a.applySavedMaxOpenResults(client)
+			a.setClient(client)
 			a.logger.Info("PagerDuty client initialized successfully")
 
 			// Fetch and cache user ID on startup
@@ -406,7 +803,7 @@ func (a *App) GetFilterByUser() bool {
 	return a.filterByUser
 }
 
-func (a *App) fetchAndUpdateIncidents() {
+func (a *App) fetchAndUpdateIncidents() error {
 	// This method now serves as a unified update trigger
 	a.mu.RLock()
 	shouldFilterByUser := a.filterByUser
@@ -416,23 +813,25 @@ func (a *App) fetchAndUpdateIncidents() {
 		// When filtering by user, fetch BOTH service and user incidents
 		// This ensures we get the union of selected services AND assigned incidents
 		var wg sync.WaitGroup
+		var serviceErr, userErr error
 		wg.Add(2)
 
 		go func() {
 			defer wg.Done()
-			a.fetchServiceIncidents()
+			serviceErr = a.fetchServiceIncidents()
 		}()
 
 		go func() {
 			defer wg.Done()
-			a.fetchUserIncidents()
+			userErr = a.fetchUserIncidents()
 		}()
 
 		wg.Wait()
-	} else {
-		// Only fetch service incidents when not filtering by user
-		a.fetchServiceIncidents()
+		return errors.Join(serviceErr, userErr)
 	}
+
+	// Only fetch service incidents when not filtering by user
+	return a.fetchServiceIncidents()
 }
 
 func (a *App) processAndUpdateIncidents(
@@ -495,7 +894,16 @@ func (a *App) processAndUpdateIncidents(
 	// The containsService guard scopes stale-marking to selected services only,
 	// so assigned incidents from NON-selected services are never wrongly resolved
 	// here — fetchUserIncidents reconciles those via its assigned-diff logic.
-	if source == "services" && len(selectedServices) > 0 {
+	if source == "all" && len(incidents) < allServicesMaxOpen {
+		// The unfiltered fetch covers every service, so anything missing was
+		// resolved. A capped response may have dropped incidents, so skip then.
+		for _, existing := range existingOpenIncidents {
+			if !currentMap[existing.IncidentID] {
+				staleIDs = append(staleIDs, existing.IncidentID)
+				a.logger.Info(fmt.Sprintf("[%s] Marking incident as resolved (not in API): %s", source, existing.IncidentID))
+			}
+		}
+	} else if source == "services" && len(selectedServices) > 0 {
 		// Find incidents that are in DB but not in current API response
 		for _, existing := range existingOpenIncidents {
 			if !currentMap[existing.IncidentID] {
@@ -529,6 +937,10 @@ func (a *App) processAndUpdateIncidents(
 		}
 	}
 
+	a.lastSuccessfulFetchMu.Lock()
+	a.lastSuccessfulFetch = time.Now()
+	a.lastSuccessfulFetchMu.Unlock()
+
 	// Get updated open incidents after database changes
 	allOpenIncidents, err := a.db.GetOpenIncidents()
 	if err != nil {
@@ -550,29 +962,80 @@ func (a *App) processAndUpdateIncidents(
 	for k, v := range a.previousOpenIncidents {
 		previousOpen[k] = v
 	}
+	seeded := a.previousOpenSeeded
 	a.previousOpenMu.RUnlock()
 
+	// Per-incident events are skipped on the first pass, when every open
+	// incident would otherwise look new
+	var statusEvents []IncidentStatusEvent
+	addEvent := func(incident database.IncidentData, status string) {
+		if seeded {
+			statusEvents = append(statusEvents, IncidentStatusEvent{
+				IncidentID: incident.IncidentID,
+				Title:      incident.Title,
+				Status:     status,
+			})
+		}
+	}
+
+	var userName string
+	if a.userCache != nil {
+		userName = a.userCache.UserName()
+	}
+
 	// Detect REAL status transitions
 	var hasTransitions bool
+	var reopened []database.IncidentData
+	var leftOpenMine []database.IncidentData
 	for id, prevIncident := range previousOpen {
 		if _, exists := currentOpen[id]; !exists {
 			// Incident truly moved from open to resolved
 			a.logger.Info(fmt.Sprintf("[%s] Detected transition to resolved: %s", source, id))
 			hasTransitions = true
+			addEvent(prevIncident, "resolved")
+			if seeded && nameInList(prevIncident.Assignees, userName) {
+				leftOpenMine = append(leftOpenMine, prevIncident)
+			}
 		} else if currentOpen[id].Status != prevIncident.Status {
 			// Status changed within open states
 			a.logger.Info(fmt.Sprintf("[%s] Status change for %s: %s -> %s",
 				source, id, prevIncident.Status, currentOpen[id].Status))
+			addEvent(currentOpen[id], currentOpen[id].Status)
+
+			// An acknowledged incident going back to triggered means the ack
+			// bounced (e.g. ack timeout) and needs attention again
+			if seeded && prevIncident.Status == "acknowledged" && currentOpen[id].Status == "triggered" {
+				reopened = append(reopened, currentOpen[id])
+			}
+		}
+	}
+
+	// Incidents that existed last poll and have just been assigned to the
+	// current user. New incidents are covered by the triggered notification.
+	var assignedToMe []database.IncidentData
+	if seeded && userName != "" {
+		for id, incident := range currentOpen {
+			prevIncident, existed := previousOpen[id]
+			if existed && !nameInList(prevIncident.Assignees, userName) && nameInList(incident.Assignees, userName) {
+				a.logger.Info(fmt.Sprintf("[%s] Incident %s reassigned to current user", source, id))
+				assignedToMe = append(assignedToMe, incident)
+			}
 		}
 	}
 
 	// Log new incidents that appeared
-	for id := range currentOpen {
+	for id, incident := range currentOpen {
 		if _, existed := previousOpen[id]; !existed {
 			a.logger.Debug(fmt.Sprintf("[%s] New incident detected: %s", source, id))
+			addEvent(incident, incident.Status)
 		}
 	}
 
+	// Incidents whose alert count grew by the configured delta since it was
+	// last notified (or first seen), so slow spreads are caught too
+	var alertsGrew []IncidentAlertGrowthEvent
+	growthDelta := int(atomic.LoadInt32(&a.alertGrowthDelta))
+
 	// If transitions detected, trigger lightweight resolved fetch
 	if hasTransitions {
 		a.logger.Info(fmt.Sprintf("[%s] Transitions detected, resolved polling will update", source))
@@ -580,16 +1043,74 @@ func (a *App) processAndUpdateIncidents(
 
 	// Update previous state with proper locking
 	a.previousOpenMu.Lock()
+	for id, incident := range currentOpen {
+		baseline, tracked := a.alertCountBaseline[id]
+		if !tracked || incident.AlertCount < baseline {
+			a.alertCountBaseline[id] = incident.AlertCount
+			continue
+		}
+		if seeded && growthDelta > 0 && incident.AlertCount-baseline >= growthDelta {
+			alertsGrew = append(alertsGrew, IncidentAlertGrowthEvent{
+				IncidentID:    id,
+				Title:         incident.Title,
+				PreviousCount: baseline,
+				AlertCount:    incident.AlertCount,
+			})
+			a.alertCountBaseline[id] = incident.AlertCount
+		}
+	}
+	for id := range a.alertCountBaseline {
+		if _, open := currentOpen[id]; !open {
+			delete(a.alertCountBaseline, id)
+		}
+	}
+	// Leaving the open list only means the incident was resolved locally by a
+	// sweep; it may have been reassigned or fallen past the result cap. The
+	// resolve notification waits until PagerDuty reports it resolved.
+	for _, incident := range leftOpenMine {
+		a.pendingResolves[incident.IncidentID] = pendingResolve{incident: incident, since: time.Now()}
+	}
+	for id, pending := range a.pendingResolves {
+		if _, open := currentOpen[id]; open || time.Since(pending.since) > resolveConfirmWindow {
+			delete(a.pendingResolves, id)
+		}
+	}
 	a.previousOpenIncidents = currentOpen
+	a.previousOpenSeeded = true
 	a.previousOpenMu.Unlock()
 
 	// Emit event to update UI
 	runtime.EventsEmit(a.ctx, "incidents-updated", "both")
+	if summary, err := a.GetOpenIncidentSummary(); err == nil {
+		runtime.EventsEmit(a.ctx, "badge-count-changed", summary)
+	} else {
+		a.logger.Warn(fmt.Sprintf("Failed to compute badge count: %v", err))
+	}
+	for _, event := range statusEvents {
+		runtime.EventsEmit(a.ctx, "incident-"+event.Status, event)
+	}
+	for _, incident := range assignedToMe {
+		a.notifyAssignedToMe(incident)
+	}
+	for _, incident := range reopened {
+		a.notifyReopenedIncident(incident)
+	}
+	for _, event := range alertsGrew {
+		a.notifyAlertGrowth(currentOpen[event.IncidentID], event)
+	}
 
 	// Check for triggered incidents and send notifications
 	a.checkForTriggeredIncidents()
 }
 
+// IncidentStatusEvent is the payload of the incident-triggered,
+// incident-acknowledged and incident-resolved events
+type IncidentStatusEvent struct {
+	IncidentID string `json:"incident_id"`
+	Title      string `json:"title"`
+	Status     string `json:"status"`
+}
+
 func containsService(services []string, serviceID string) bool {
 	for _, s := range services {
 		if s == serviceID {
@@ -624,58 +1145,39 @@ func (a *App) checkForTriggeredIncidents() {
 		return
 	}
 
-	// Get selected services to filter notifications
-	a.mu.RLock()
-	selectedServices := make([]string, len(a.selectedServices))
-	copy(selectedServices, a.selectedServices)
-	a.mu.RUnlock()
-
 	// Use dedicated mutex for lastIncidents
 	a.lastIncidentsMu.Lock()
 	defer a.lastIncidentsMu.Unlock()
 
-	for _, incident := range openIncidents {
-		// Skip notifications for incidents from non-selected services
-		if len(selectedServices) > 0 && !containsService(selectedServices, incident.ServiceID) {
-			// Still track the status for when the service is re-selected
-			a.lastIncidents[incident.IncidentID] = incident.Status
-			continue
-		}
+	// Newly triggered incidents are collected first so a burst can be
+	// summarized in one notification instead of one per incident
+	var newlyTriggered []database.IncidentData
 
+	for _, incident := range openIncidents {
 		lastStatus, exists := a.lastIncidents[incident.IncidentID]
 
-		// Check if this is a new triggered incident or status changed to triggered
-		if incident.Status == "triggered" && (!exists || lastStatus != "triggered") {
-			// Get the configured service name for the say command
-			serviceName := a.GetServiceNameByID(incident.ServiceID)
-			if serviceName == "" {
-				// Fallback to service summary if no configured name found
-				serviceName = incident.ServiceSummary
-			}
-
-			// Send notification for triggered incident
-			if a.notificationMgr != nil {
-				err := a.notificationMgr.SendNotification(
-					incident.ServiceSummary, // Title for terminal-notifier
-					incident.Title,          // Message for terminal-notifier
-					incident.HTMLURL,        // URL for click-to-open
-					serviceName,             // Service name for say command
-				)
-				if err != nil {
-					a.logger.Error(fmt.Sprintf("Failed to send notification: %v", err))
-				}
-				a.logger.Info(fmt.Sprintf("Notification sent for triggered incident: %s (service: %s)",
-					incident.IncidentID, serviceName))
-
-				// Queue browser redirect if enabled
-				a.notificationMgr.QueueBrowserRedirect(incident.IncidentID, incident.HTMLURL)
+		// Check if this is a new triggered incident or status changed to triggered.
+		// Acknowledged -> triggered is notified as a reopen by
+		// processAndUpdateIncidents instead.
+		if incident.Status == "triggered" && (!exists || (lastStatus != "triggered" && lastStatus != "acknowledged")) {
+			if a.shouldNotify(incident) {
+				newlyTriggered = append(newlyTriggered, incident)
 			}
 		}
 
-		// Update last known status
+		// Update last known status, also for skipped incidents so they don't
+		// notify later when e.g. their service is re-selected
 		a.lastIncidents[incident.IncidentID] = incident.Status
 	}
 
+	if len(newlyTriggered) > int(atomic.LoadInt32(&a.stormThreshold)) {
+		a.notifyIncidentStorm(newlyTriggered)
+	} else {
+		for _, incident := range newlyTriggered {
+			a.notifyTriggeredIncident(incident)
+		}
+	}
+
 	// Clean up resolved incidents from tracking
 	incidentMap := make(map[string]bool)
 	for _, incident := range openIncidents {
@@ -690,65 +1192,575 @@ func (a *App) checkForTriggeredIncidents() {
 	}
 }
 
-func (a *App) SetBrowserRedirect(enabled bool) {
-	if a.notificationMgr != nil {
-		a.notificationMgr.SetBrowserRedirect(enabled)
-
-		// Persist the setting
-		if a.db != nil {
-			value := "false"
-			if enabled {
-				value = "true"
-			}
-			if err := a.db.SetState("browser_redirect", value); err != nil {
-				a.logger.Error(fmt.Sprintf("Failed to persist browser redirect setting: %v", err))
-			}
-		}
+// serviceDisplayName returns the configured name for an incident's service,
+// falling back to the service summary
+func (a *App) serviceDisplayName(incident database.IncidentData) string {
+	if name := a.GetServiceNameByID(incident.ServiceID); name != "" {
+		return name
 	}
+	return incident.ServiceSummary
 }
 
-func (a *App) GetBrowserRedirect() bool {
-	if a.notificationMgr != nil {
-		config := a.notificationMgr.GetConfig()
-		return config.BrowserRedirect
+// shouldNotify reports whether an incident passes the checks shared by every
+// incident notification: notifications are running, its service is selected
+// (or "all services" mode is on), the service isn't suppressed and the
+// incident meets the minimum urgency
+func (a *App) shouldNotify(incident database.IncidentData) bool {
+	if a.notificationMgr == nil || a.isPaused() {
+		return false
 	}
-	return false
-}
 
-// SetTheme persists the UI theme preference ("light" or "dark").
-func (a *App) SetTheme(theme string) error {
-	if theme != "light" && theme != "dark" {
-		return fmt.Errorf("invalid theme: %s", theme)
+	a.mu.RLock()
+	selected := a.monitoringAll() || len(a.selectedServices) == 0 || containsService(a.selectedServices, incident.ServiceID)
+	a.mu.RUnlock()
+	if !selected {
+		return false
 	}
 
-	if a.db == nil {
-		return fmt.Errorf("database not initialized")
+	if a.notificationsSuppressed(incident.ServiceID) {
+		a.logger.Debug(fmt.Sprintf("Skipping notification for suppressed service incident: %s", incident.IncidentID))
+		return false
 	}
-
-	if err := a.db.SetState("theme", theme); err != nil {
-		if a.logger != nil {
-			a.logger.Error(fmt.Sprintf("Failed to persist theme setting: %v", err))
-		}
-		return err
+	if !a.notificationMgr.MeetsMinUrgency(incident.Urgency) {
+		a.logger.Debug(fmt.Sprintf("Skipping notification for %s urgency incident: %s", incident.Urgency, incident.IncidentID))
+		return false
 	}
+	return true
+}
 
-	if a.logger != nil {
-		a.logger.Info(fmt.Sprintf("Theme set to: %s", theme))
+// sendIncidentNotification sends a notification about an incident with its
+// service's sound, the acknowledge action and click-to-open
+func (a *App) sendIncidentNotification(incident database.IncidentData, title, message string) error {
+	return a.notificationMgr.SendNotificationWithSound(
+		incident.IncidentID,            // For the acknowledge action
+		title,                          // Title for terminal-notifier
+		message,                        // Message for terminal-notifier
+		incident.HTMLURL,               // URL for click-to-open
+		a.serviceDisplayName(incident), // Service name for say command
+		a.notificationMgr.SoundForService(incident.ServiceID),
+	)
+}
+
+// notifyTriggeredIncident sends the notification for a single triggered incident
+func (a *App) notifyTriggeredIncident(incident database.IncidentData) {
+	title, message := a.notificationMgr.FormatIncident(incident)
+	if err := a.sendIncidentNotification(incident, title, message); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to send notification: %v", err))
 	}
-	return nil
+	a.logger.Info(fmt.Sprintf("Notification sent for triggered incident: %s (service: %s)",
+		incident.IncidentID, a.serviceDisplayName(incident)))
+
+	// Queue browser redirect if enabled
+	a.notificationMgr.QueueBrowserRedirect(incident.IncidentID, incident.HTMLURL)
 }
 
-// GetTheme returns the persisted UI theme preference, defaulting to "dark".
-func (a *App) GetTheme() string {
-	if a.db == nil {
-		return "dark"
+// notifyAssignedToMe sends a notification for an existing incident that was
+// just reassigned to the current user and emits incident-assigned-to-me
+func (a *App) notifyAssignedToMe(incident database.IncidentData) {
+	runtime.EventsEmit(a.ctx, "incident-assigned-to-me", IncidentStatusEvent{
+		IncidentID: incident.IncidentID,
+		Title:      incident.Title,
+		Status:     incident.Status,
+	})
+
+	if !a.shouldNotify(incident) {
+		return
 	}
 
-	value, err := a.db.GetState("theme")
-	if err != nil || value == "" {
-		return "dark"
+	_, message := a.notificationMgr.FormatIncident(incident)
+	if err := a.sendIncidentNotification(incident, "Assigned to you", message); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to send assignment notification: %v", err))
 	}
-	return value
+	a.logger.Info(fmt.Sprintf("Notification sent for incident assigned to current user: %s", incident.IncidentID))
+}
+
+// notifyReopenedIncident notifies about an acknowledged incident that went back
+// to triggered and emits incident-reopened
+func (a *App) notifyReopenedIncident(incident database.IncidentData) {
+	a.logger.Warn(fmt.Sprintf("Incident %s reopened: acknowledged -> triggered", incident.IncidentID))
+	runtime.EventsEmit(a.ctx, "incident-reopened", IncidentStatusEvent{
+		IncidentID: incident.IncidentID,
+		Title:      incident.Title,
+		Status:     incident.Status,
+	})
+
+	if !a.shouldNotify(incident) {
+		return
+	}
+
+	_, message := a.notificationMgr.FormatIncident(incident)
+	if err := a.sendIncidentNotification(incident, "Incident reopened", message); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to send reopen notification: %v", err))
+	}
+}
+
+// resolveConfirmWindow is how long an incident that left the open list waits
+// for PagerDuty to report it resolved before no resolve notification is sent
+const resolveConfirmWindow = 30 * time.Minute
+
+// pendingResolve is an incident assigned to the current user that left the
+// open list, as last seen open
+type pendingResolve struct {
+	incident database.IncidentData
+	since    time.Time
+}
+
+// confirmResolvedIncidents sends the resolve notification for pending
+// incidents that PagerDuty now reports resolved. incidents must come from the
+// API or a webhook, never from local sweeps.
+func (a *App) confirmResolvedIncidents(incidents []database.IncidentData) {
+	var confirmed []database.IncidentData
+
+	a.previousOpenMu.Lock()
+	for _, incident := range incidents {
+		if incident.Status != "resolved" {
+			continue
+		}
+		if pending, ok := a.pendingResolves[incident.IncidentID]; ok {
+			delete(a.pendingResolves, incident.IncidentID)
+			confirmed = append(confirmed, pending.incident)
+		}
+	}
+	a.previousOpenMu.Unlock()
+
+	for _, incident := range confirmed {
+		a.notifyResolvedIncident(incident)
+	}
+}
+
+// notifyResolvedIncident sends a quiet notification that an incident assigned
+// to the current user was resolved, if NotifyOnResolve is enabled
+func (a *App) notifyResolvedIncident(incident database.IncidentData) {
+	if a.notificationMgr == nil || a.isPaused() || !a.notificationMgr.GetConfig().NotifyOnResolve {
+		return
+	}
+
+	_, message := a.notificationMgr.FormatIncident(incident)
+	if err := a.notificationMgr.SendQuietNotification("Incident resolved", message, incident.HTMLURL); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to send resolve notification: %v", err))
+		return
+	}
+	a.logger.Info(fmt.Sprintf("Resolve notification sent for incident %s", incident.IncidentID))
+}
+
+// defaultAlertGrowthDelta is how many alerts an open incident must gain before
+// it is notified as growing
+const defaultAlertGrowthDelta = 5
+
+// IncidentAlertGrowthEvent is the payload of the incident-alerts-grew event
+type IncidentAlertGrowthEvent struct {
+	IncidentID    string `json:"incident_id"`
+	Title         string `json:"title"`
+	PreviousCount int    `json:"previous_count"`
+	AlertCount    int    `json:"alert_count"`
+}
+
+// notifyAlertGrowth notifies that an open incident's alert count grew past
+// the configured delta and emits incident-alerts-grew
+func (a *App) notifyAlertGrowth(incident database.IncidentData, event IncidentAlertGrowthEvent) {
+	a.logger.Warn(fmt.Sprintf("Incident %s alerts grew: %d -> %d", event.IncidentID, event.PreviousCount, event.AlertCount))
+	runtime.EventsEmit(a.ctx, "incident-alerts-grew", event)
+
+	if !a.shouldNotify(incident) {
+		return
+	}
+
+	message := fmt.Sprintf("%s: %d alerts (was %d)", incident.Title, event.AlertCount, event.PreviousCount)
+	if err := a.sendIncidentNotification(incident, "Incident alerts growing", message); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to send alert growth notification: %v", err))
+	}
+}
+
+// SetAlertGrowthDelta sets how many alerts an open incident must gain before
+// it is notified as growing. 0 disables the notification.
+func (a *App) SetAlertGrowthDelta(n int) error {
+	if n < 0 {
+		return fmt.Errorf("alert growth delta cannot be negative")
+	}
+
+	atomic.StoreInt32(&a.alertGrowthDelta, int32(n))
+
+	if a.db != nil {
+		if err := a.db.SetStateInt("alert_growth_delta", n); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist alert growth delta: %v", err))
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("Alert growth delta set to %d", n))
+	return nil
+}
+
+// defaultStormThreshold is how many incidents may trigger in one poll before
+// they are summarized in a single notification
+const defaultStormThreshold = 5
+
+// IncidentStormEvent is the payload of the incident-storm event
+type IncidentStormEvent struct {
+	Count         int            `json:"count"`
+	ServiceCounts map[string]int `json:"service_counts"` // service name -> new incidents
+	IncidentIDs   []string       `json:"incident_ids"`
+}
+
+// notifyIncidentStorm sends one summary notification for a burst of newly
+// triggered incidents and emits incident-storm
+func (a *App) notifyIncidentStorm(incidents []database.IncidentData) {
+	event := IncidentStormEvent{
+		Count:         len(incidents),
+		ServiceCounts: make(map[string]int),
+	}
+	for _, incident := range incidents {
+		event.ServiceCounts[a.serviceDisplayName(incident)]++
+		event.IncidentIDs = append(event.IncidentIDs, incident.IncidentID)
+	}
+
+	message := fmt.Sprintf("%d new incidents across %d services", event.Count, len(event.ServiceCounts))
+	spoken := "Multiple services"
+	sound := a.notificationMgr.GetConfig().Sound
+	if len(event.ServiceCounts) == 1 {
+		spoken = a.serviceDisplayName(incidents[0])
+		message = fmt.Sprintf("%d new incidents on %s", event.Count, spoken)
+		sound = a.notificationMgr.SoundForService(incidents[0].ServiceID)
+	}
+
+	if err := a.notificationMgr.SendNotificationWithSound("", "Incident storm", message, "", spoken, sound); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to send storm notification: %v", err))
+	}
+	a.logger.Warn(fmt.Sprintf("Incident storm detected: %s", message))
+	runtime.EventsEmit(a.ctx, "incident-storm", event)
+}
+
+// SetStormThreshold sets how many incidents may trigger in a single poll before
+// they are summarized in one notification instead of notified individually
+func (a *App) SetStormThreshold(n int) error {
+	if n < 1 {
+		return fmt.Errorf("storm threshold must be at least 1")
+	}
+
+	atomic.StoreInt32(&a.stormThreshold, int32(n))
+
+	if a.db != nil {
+		if err := a.db.SetStateInt("storm_threshold", n); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist storm threshold: %v", err))
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("Incident storm threshold set to %d", n))
+	return nil
+}
+
+// Bounds for how many resolved incidents are returned to the UI. The maximum
+// keeps the list from holding an unbounded number of rows in memory.
+const (
+	defaultResolvedDisplayLimit = 100
+	minResolvedDisplayLimit     = 1
+	maxResolvedDisplayLimit     = 1000
+)
+
+// SetResolvedDisplayLimit sets how many of the most recent resolved incidents
+// are shown
+func (a *App) SetResolvedDisplayLimit(n int) error {
+	if n < minResolvedDisplayLimit || n > maxResolvedDisplayLimit {
+		return fmt.Errorf("resolved display limit must be between %d and %d", minResolvedDisplayLimit, maxResolvedDisplayLimit)
+	}
+
+	atomic.StoreInt32(&a.resolvedDisplayMax, int32(n))
+
+	if a.db != nil {
+		if err := a.db.SetStateInt("resolved_display_limit", n); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist resolved display limit: %v", err))
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("Resolved display limit set to %d", n))
+	runtime.EventsEmit(a.ctx, "incidents-updated", "resolved")
+	return nil
+}
+
+// GetResolvedDisplayLimit returns how many resolved incidents are shown
+func (a *App) GetResolvedDisplayLimit() int {
+	return a.resolvedDisplayLimit()
+}
+
+func (a *App) resolvedDisplayLimit() int {
+	return int(atomic.LoadInt32(&a.resolvedDisplayMax))
+}
+
+// SetNotifyOnResolve toggles the quiet notification sent when an incident
+// assigned to the current user is resolved
+func (a *App) SetNotifyOnResolve(enabled bool) {
+	if a.notificationMgr != nil {
+		a.notificationMgr.SetNotifyOnResolve(enabled)
+		a.saveNotificationConfig()
+	}
+}
+
+func (a *App) SetBrowserRedirect(enabled bool) {
+	if a.notificationMgr != nil {
+		a.notificationMgr.SetBrowserRedirect(enabled)
+		a.saveNotificationConfig()
+	}
+}
+
+// saveNotificationConfig persists the current notification config so it
+// survives restarts
+func (a *App) saveNotificationConfig() {
+	if a.db == nil || a.notificationMgr == nil {
+		return
+	}
+
+	data, err := json.Marshal(a.notificationMgr.GetConfig())
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to encode notification config: %v", err))
+		return
+	}
+	if err := a.db.SetState("notification_config", string(data)); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to persist notification config: %v", err))
+	}
+}
+
+func (a *App) GetBrowserRedirect() bool {
+	if a.notificationMgr != nil {
+		config := a.notificationMgr.GetConfig()
+		return config.BrowserRedirect
+	}
+	return false
+}
+
+// SetTheme persists the UI theme preference ("light" or "dark").
+func (a *App) SetTheme(theme string) error {
+	if theme != "light" && theme != "dark" {
+		return fmt.Errorf("invalid theme: %s", theme)
+	}
+
+	if a.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	if err := a.db.SetState("theme", theme); err != nil {
+		if a.logger != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist theme setting: %v", err))
+		}
+		return err
+	}
+
+	if a.logger != nil {
+		a.logger.Info(fmt.Sprintf("Theme set to: %s", theme))
+	}
+	return nil
+}
+
+// SetLogLevel sets the minimum log level ("debug", "info", "warn" or "error") and persists it
+func (a *App) SetLogLevel(level string) error {
+	logLevel, err := ParseLogLevel(level)
+	if err != nil {
+		return err
+	}
+
+	if a.logger == nil {
+		return fmt.Errorf("logger not initialized")
+	}
+
+	a.logger.SetLogLevel(logLevel)
+	a.logger.Info(fmt.Sprintf("Log level set to: %s", logLevel))
+
+	if a.db != nil {
+		if err := a.db.SetState("log_level", logLevel.String()); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist log level: %v", err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// maxRecentLogLines caps how much of the log GetRecentLogs keeps in memory
+const maxRecentLogLines = 5000
+
+// GetRecentLogs returns the last `lines` lines of the current log file
+func (a *App) GetRecentLogs(lines int) ([]string, error) {
+	if a.logger == nil {
+		return nil, fmt.Errorf("logger not initialized")
+	}
+
+	if lines <= 0 {
+		return []string{}, nil
+	}
+	if lines > maxRecentLogLines {
+		lines = maxRecentLogLines
+	}
+
+	path := a.logger.LogFilePath()
+	result, err := tailFile(path, lines)
+	if err != nil {
+		// The file may be mid-rotation; give the new file a moment to appear
+		time.Sleep(100 * time.Millisecond)
+		result, err = tailFile(path, lines)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read log file: %w", err)
+	}
+
+	return result, nil
+}
+
+// LogFileInfo describes a log file in the log directory
+type LogFileInfo struct {
+	Name    string    `json:"name"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+	Current bool      `json:"current"` // the file being written to; it can't be deleted
+}
+
+// ListLogFiles returns the current and rotated log files, newest first
+func (a *App) ListLogFiles() ([]LogFileInfo, error) {
+	if a.logger == nil {
+		return nil, fmt.Errorf("logger not initialized")
+	}
+
+	logDir := filepath.Dir(a.logger.LogFilePath())
+	entries, err := os.ReadDir(logDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read log directory: %w", err)
+	}
+
+	current := filepath.Base(a.logger.LogFilePath())
+	files := []LogFileInfo{}
+	for _, entry := range entries {
+		if entry.IsDir() || !isLogFileName(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, LogFileInfo{
+			Name:    entry.Name(),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+			Current: entry.Name() == current,
+		})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime.After(files[j].ModTime)
+	})
+
+	return files, nil
+}
+
+// DeleteLogFile deletes a rotated log file. Only bare app*.log names in the
+// log directory are accepted, and the current log file is refused.
+func (a *App) DeleteLogFile(name string) error {
+	if a.logger == nil {
+		return fmt.Errorf("logger not initialized")
+	}
+
+	if name == "" || name != filepath.Base(name) || !isLogFileName(name) {
+		return fmt.Errorf("invalid log file name: %s", name)
+	}
+
+	if name == filepath.Base(a.logger.LogFilePath()) {
+		return fmt.Errorf("cannot delete the current log file")
+	}
+
+	path := filepath.Join(filepath.Dir(a.logger.LogFilePath()), name)
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to delete log file %s: %w", name, err)
+	}
+
+	a.logger.Info(fmt.Sprintf("Deleted log file %s", name))
+	return nil
+}
+
+// isLogFileName reports whether name looks like one of our log files
+func isLogFileName(name string) bool {
+	matched, err := filepath.Match("app*.log", name)
+	return err == nil && matched
+}
+
+// tailFile returns the last n lines of a file, reading it line by line so
+// only n lines are held at once
+func tailFile(path string, n int) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	ring := make([]string, 0, n)
+	start := 0
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if len(ring) < n {
+			ring = append(ring, scanner.Text())
+		} else {
+			ring[start] = scanner.Text()
+			start = (start + 1) % n
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return append(ring[start:], ring[:start]...), nil
+}
+
+// maxLogDedupWindowSeconds caps the window accepted by SetLogDedupWindow
+const maxLogDedupWindowSeconds = 300
+
+// SetLogDedupWindow sets how many seconds identical log messages are collapsed
+// into a repeat count, and persists it. 0 writes every message, which helps
+// when diagnosing the timing of rapid-fire polling.
+func (a *App) SetLogDedupWindow(seconds int) error {
+	if seconds < 0 || seconds > maxLogDedupWindowSeconds {
+		return fmt.Errorf("dedup window must be between 0 and %d seconds", maxLogDedupWindowSeconds)
+	}
+
+	if a.logger == nil {
+		return fmt.Errorf("logger not initialized")
+	}
+
+	a.logger.SetDedupWindow(time.Duration(seconds) * time.Second)
+	a.logger.Info(fmt.Sprintf("Log dedup window set to %ds", seconds))
+
+	if a.db != nil {
+		if err := a.db.SetStateInt("log_dedup_window", seconds); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist log dedup window: %v", err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetLogDedupWindow returns the log dedup window in seconds
+func (a *App) GetLogDedupWindow() int {
+	if a.logger == nil {
+		return int(defaultDedupWindow / time.Second)
+	}
+	return int(a.logger.GetDedupWindow() / time.Second)
+}
+
+// GetLogLevel returns the current minimum log level name
+func (a *App) GetLogLevel() string {
+	if a.logger == nil {
+		return INFO.String()
+	}
+	return a.logger.GetLogLevel().String()
+}
+
+// GetTheme returns the persisted UI theme preference, defaulting to "dark".
+func (a *App) GetTheme() string {
+	if a.db == nil {
+		return "dark"
+	}
+
+	value, err := a.db.GetState("theme")
+	if err != nil || value == "" {
+		return "dark"
+	}
+	return value
 }
 
 func (a *App) StartPolling() {
@@ -758,13 +1770,21 @@ func (a *App) StartPolling() {
 	if a.polling {
 		return
 	}
+	if a.isPaused() {
+		a.logger.Info("Polling is paused, not starting service incidents polling")
+		return
+	}
+
+	interval, _ := a.effectivePollIntervals()
 
 	a.polling = true
-	a.pollTicker = time.NewTicker(3 * time.Second)
-	a.logger.Info("Started service incidents polling (3s interval)")
+	a.pollTicker = time.NewTicker(interval)
+	a.pollStop = make(chan struct{})
+	a.logger.Info(fmt.Sprintf("Started service incidents polling (%v interval)", interval))
 
-	// Store ticker channel reference while holding lock
+	// Store ticker and stop channel references while holding lock
 	tickerChan := a.pollTicker.C
+	stopChan := a.pollStop
 
 	a.shutdownWg.Add(1)
 	go func() {
@@ -778,6 +1798,8 @@ func (a *App) StartPolling() {
 			case <-a.shutdownChan:
 				a.logger.Info("Service incidents polling stopped by shutdown signal")
 				return
+			case <-stopChan:
+				return
 			case <-tickerChan:
 				// Check polling state with lock
 				a.pollMu.RLock()
@@ -812,13 +1834,21 @@ func (a *App) StartUserPolling() {
 	if a.userPolling {
 		return
 	}
+	if a.isPaused() {
+		a.logger.Info("Polling is paused, not starting user incidents polling")
+		return
+	}
+
+	_, interval := a.effectivePollIntervals()
 
 	a.userPolling = true
-	a.userPollTicker = time.NewTicker(4 * time.Second)
-	a.logger.Info("Started user incidents polling (4s interval)")
+	a.userPollTicker = time.NewTicker(interval)
+	a.userPollStop = make(chan struct{})
+	a.logger.Info(fmt.Sprintf("Started user incidents polling (%v interval)", interval))
 
-	// Store ticker channel reference while holding lock
+	// Store ticker and stop channel references while holding lock
 	tickerChan := a.userPollTicker.C
+	stopChan := a.userPollStop
 
 	a.shutdownWg.Add(1)
 	go func() {
@@ -838,6 +1868,8 @@ func (a *App) StartUserPolling() {
 			case <-a.shutdownChan:
 				a.logger.Info("User incidents polling stopped by shutdown signal")
 				return
+			case <-stopChan:
+				return
 			case <-tickerChan:
 				// Check polling state with lock
 				a.userPollMu.RLock()
@@ -880,6 +1912,10 @@ func (a *App) StopUserPolling() {
 		a.userPollTicker.Stop()
 		a.userPollTicker = nil
 	}
+	if a.userPollStop != nil {
+		close(a.userPollStop)
+		a.userPollStop = nil
+	}
 	a.logger.Info("Stopped user incidents polling")
 }
 
@@ -893,6 +1929,10 @@ func (a *App) StopPolling() {
 		a.pollTicker.Stop()
 		a.pollTicker = nil
 	}
+	if a.pollStop != nil {
+		close(a.pollStop)
+		a.pollStop = nil
+	}
 	a.logger.Info("Stopped incident polling")
 }
 
@@ -903,13 +1943,23 @@ func (a *App) StartResolvedPolling() {
 	if a.resolvedPolling {
 		return
 	}
+	if a.isPaused() {
+		a.logger.Info("Polling is paused, not starting resolved incidents polling")
+		return
+	}
+
+	a.intervalsMu.RLock()
+	interval := a.resolvedInterval
+	a.intervalsMu.RUnlock()
 
 	a.resolvedPolling = true
-	a.resolvedPollTicker = time.NewTicker(1 * time.Minute) // Changed from 10 minutes to 1 minute
-	a.logger.Info("Started resolved incidents polling (1m interval)")
+	a.resolvedPollTicker = time.NewTicker(interval)
+	a.resolvedPollStop = make(chan struct{})
+	a.logger.Info(fmt.Sprintf("Started resolved incidents polling (%v interval)", interval))
 
-	// Store ticker channel reference while holding lock
+	// Store ticker and stop channel references while holding lock
 	tickerChan := a.resolvedPollTicker.C
+	stopChan := a.resolvedPollStop
 
 	a.shutdownWg.Add(1)
 	go func() {
@@ -923,6 +1973,8 @@ func (a *App) StartResolvedPolling() {
 			case <-a.shutdownChan:
 				a.logger.Info("Resolved incidents polling stopped by shutdown signal")
 				return
+			case <-stopChan:
+				return
 			case <-tickerChan:
 				a.resolvedPollMu.RLock()
 				shouldContinue := a.resolvedPolling
@@ -951,6 +2003,193 @@ func (a *App) StartResolvedPolling() {
 	}()
 }
 
+// SetPollingIntervals updates the service, user and resolved polling intervals
+// (in seconds), persists them, and applies them to any running tickers.
+func (a *App) SetPollingIntervals(serviceSeconds, userSeconds, resolvedSeconds int) error {
+	service := time.Duration(serviceSeconds) * time.Second
+	user := time.Duration(userSeconds) * time.Second
+	resolved := time.Duration(resolvedSeconds) * time.Second
+
+	if service < minServiceInterval {
+		return fmt.Errorf("service polling interval must be at least %v", minServiceInterval)
+	}
+	if user < minUserInterval {
+		return fmt.Errorf("user polling interval must be at least %v", minUserInterval)
+	}
+	if resolved < minResolvedInterval {
+		return fmt.Errorf("resolved polling interval must be at least %v", minResolvedInterval)
+	}
+
+	a.intervalsMu.Lock()
+	a.serviceInterval = service
+	a.userInterval = user
+	a.resolvedInterval = resolved
+	a.intervalsMu.Unlock()
+
+	// Persist the new intervals
+	if a.db != nil {
+		settings := map[string]int{
+			"poll_interval_service":  serviceSeconds,
+			"poll_interval_user":     userSeconds,
+			"poll_interval_resolved": resolvedSeconds,
+		}
+		for key, value := range settings {
+			if err := a.db.SetStateInt(key, value); err != nil {
+				a.logger.Error(fmt.Sprintf("Failed to persist %s: %v", key, err))
+			}
+		}
+	}
+
+	a.resetPollTickers()
+
+	a.logger.Info(fmt.Sprintf("Polling intervals set to service=%v user=%v resolved=%v", service, user, resolved))
+	return nil
+}
+
+// effectivePollIntervals returns the service and user polling intervals in use.
+// While the webhook receiver is running, polling only acts as a safety net.
+func (a *App) effectivePollIntervals() (service, user time.Duration) {
+	a.intervalsMu.RLock()
+	defer a.intervalsMu.RUnlock()
+
+	service, user = a.serviceInterval, a.userInterval
+	if a.webhookActive {
+		service = max(service, webhookPollInterval)
+		user = max(user, webhookPollInterval)
+	}
+	return service, user
+}
+
+// resetPollTickers resets running tickers in place so the polling goroutines
+// pick up new durations without being restarted
+func (a *App) resetPollTickers() {
+	service, user := a.effectivePollIntervals()
+	a.intervalsMu.RLock()
+	resolved := a.resolvedInterval
+	a.intervalsMu.RUnlock()
+
+	a.pollMu.Lock()
+	if a.pollTicker != nil {
+		a.pollTicker.Reset(service)
+	}
+	a.pollMu.Unlock()
+
+	a.userPollMu.Lock()
+	if a.userPollTicker != nil {
+		a.userPollTicker.Reset(user)
+	}
+	a.userPollMu.Unlock()
+
+	a.resolvedPollMu.Lock()
+	if a.resolvedPollTicker != nil {
+		a.resolvedPollTicker.Reset(resolved)
+	}
+	a.resolvedPollMu.Unlock()
+}
+
+// GetPollingIntervals returns the current polling intervals in seconds
+func (a *App) GetPollingIntervals() map[string]int {
+	a.intervalsMu.RLock()
+	defer a.intervalsMu.RUnlock()
+
+	return map[string]int{
+		"service":  int(a.serviceInterval / time.Second),
+		"user":     int(a.userInterval / time.Second),
+		"resolved": int(a.resolvedInterval / time.Second),
+	}
+}
+
+// loadPollingIntervals restores persisted polling intervals, ignoring any
+// value below the enforced minimum.
+func (a *App) loadPollingIntervals() {
+	if a.db == nil {
+		return
+	}
+
+	load := func(key string, min time.Duration, target *time.Duration) {
+		seconds, err := a.db.GetStateInt(key)
+		if err != nil {
+			return
+		}
+		if d := time.Duration(seconds) * time.Second; d >= min {
+			*target = d
+		} else {
+			a.logger.Warn(fmt.Sprintf("Ignoring %s below the minimum: %d", key, seconds))
+		}
+	}
+
+	a.intervalsMu.Lock()
+	load("poll_interval_service", minServiceInterval, &a.serviceInterval)
+	load("poll_interval_user", minUserInterval, &a.userInterval)
+	load("poll_interval_resolved", minResolvedInterval, &a.resolvedInterval)
+	a.intervalsMu.Unlock()
+
+	a.logger.Info(fmt.Sprintf("Polling intervals: service=%v user=%v resolved=%v",
+		a.serviceInterval, a.userInterval, a.resolvedInterval))
+}
+
+// SetResolvedLookbackHours sets how far back resolved incidents are fetched.
+// Increasing the window backfills the newly covered range immediately.
+func (a *App) SetResolvedLookbackHours(hours int) error {
+	if hours < minResolvedLookbackHours || hours > maxResolvedLookbackHours {
+		return fmt.Errorf("resolved lookback must be between %d and %d hours", minResolvedLookbackHours, maxResolvedLookbackHours)
+	}
+
+	lookback := time.Duration(hours) * time.Hour
+
+	a.intervalsMu.Lock()
+	previous := a.resolvedLookback
+	a.resolvedLookback = lookback
+	a.intervalsMu.Unlock()
+
+	if a.db != nil {
+		if err := a.db.SetStateInt("resolved_lookback_hours", hours); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist resolved lookback: %v", err))
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("Resolved lookback set to %v", lookback))
+
+	if lookback > previous && a.getClient() != nil {
+		go a.performInitialResolvedFetch()
+	}
+
+	return nil
+}
+
+// GetResolvedLookbackHours returns how far back resolved incidents are fetched
+func (a *App) GetResolvedLookbackHours() int {
+	return int(a.resolvedLookbackWindow() / time.Hour)
+}
+
+// resolvedLookbackWindow returns the configured resolved fetch window
+func (a *App) resolvedLookbackWindow() time.Duration {
+	a.intervalsMu.RLock()
+	defer a.intervalsMu.RUnlock()
+	return a.resolvedLookback
+}
+
+// loadResolvedLookback restores the persisted resolved lookback, ignoring
+// values outside the allowed range.
+func (a *App) loadResolvedLookback() {
+	if a.db == nil {
+		return
+	}
+
+	hours, err := a.db.GetStateInt("resolved_lookback_hours")
+	if err != nil {
+		return
+	}
+	if hours < minResolvedLookbackHours || hours > maxResolvedLookbackHours {
+		a.logger.Warn(fmt.Sprintf("Ignoring invalid resolved_lookback_hours: %d", hours))
+		return
+	}
+
+	a.intervalsMu.Lock()
+	a.resolvedLookback = time.Duration(hours) * time.Hour
+	a.intervalsMu.Unlock()
+}
+
 func (a *App) StopResolvedPolling() {
 	a.resolvedPollMu.Lock()
 	defer a.resolvedPollMu.Unlock()
@@ -960,25 +2199,38 @@ func (a *App) StopResolvedPolling() {
 		a.resolvedPollTicker.Stop()
 		a.resolvedPollTicker = nil
 	}
+	if a.resolvedPollStop != nil {
+		close(a.resolvedPollStop)
+		a.resolvedPollStop = nil
+	}
 	a.logger.Info("Stopped resolved incidents polling")
 }
 
-func (a *App) fetchServiceIncidents() {
-	if a.client == nil {
-		return
+func (a *App) fetchServiceIncidents() error {
+	client := a.getClient()
+	if client == nil || a.isPaused() {
+		return nil
 	}
 
 	// Check if shutdown is in progress
 	select {
 	case <-a.shutdownChan:
-		return
+		return nil
 	default:
 	}
 
+	// Service polling is the steadiest signal, so recheck the connection
+	// status after each attempt
+	defer a.updateConnectionStatus()
+
 	// Check circuit breaker
 	if !a.circuitBreaker.Allow() {
 		a.logger.Warn("Circuit breaker open, skipping service fetch")
-		return
+		return fmt.Errorf("circuit breaker open")
+	}
+
+	if a.monitoringAll() {
+		return a.fetchFilteredIncidents(client, nil)
 	}
 
 	// Get selected services with proper locking
@@ -991,40 +2243,80 @@ func (a *App) fetchServiceIncidents() {
 		if err := a.db.RemoveStaleOpenIncidents([]string{}, []string{}); err != nil {
 			a.logger.Error(fmt.Sprintf("Failed to clear stale incidents: %v", err))
 		}
-		return
+		return nil
+	}
+
+	if len(a.teamFilterIDs()) > 0 {
+		return a.fetchFilteredIncidents(client, selectedServices)
 	}
 
 	// Fetch open incidents for services WITHOUT user filtering
 	incidents, err := a.fetchWithRetry(func() ([]database.IncidentData, error) {
-		return a.client.FetchOpenIncidents(selectedServices, "")
+		return client.FetchOpenIncidents(selectedServices, "")
 	}, 3)
 
 	if err != nil {
 		a.logger.Error(fmt.Sprintf("Failed to fetch service incidents after retries: %v", err))
 		a.circuitBreaker.RecordFailure()
-		return
+		return fmt.Errorf("failed to fetch service incidents: %w", err)
 	}
 
 	a.circuitBreaker.RecordSuccess()
 	a.processAndUpdateIncidents(incidents, "services")
+	return nil
 }
 
-func (a *App) fetchUserIncidents() {
-	if a.client == nil {
-		return
+// allServicesMaxOpen caps the open incidents fetched in "all services" mode
+// or with a team filter
+const allServicesMaxOpen = 500
+
+// fetchFilteredIncidents fetches open incidents for "all services" mode
+// (serviceIDs empty) or with a team filter. A team-filtered response omits the
+// selected services' other incidents, so it is processed as "teams", which
+// skips stale marking; resolved polling reconciles those instead.
+func (a *App) fetchFilteredIncidents(client store.PagerDutyClient, serviceIDs []string) error {
+	opts := store.FetchOptions{
+		ServiceIDs: serviceIDs,
+		TeamIDs:    a.teamFilterIDs(),
+		Statuses:   []string{"triggered", "acknowledged"},
+		MaxResults: allServicesMaxOpen,
+	}
+	incidents, err := a.fetchWithRetry(func() ([]database.IncidentData, error) {
+		return client.FetchIncidentsWithPagination(opts, 100)
+	}, 3)
+
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to fetch filtered incidents after retries: %v", err))
+		a.circuitBreaker.RecordFailure()
+		return fmt.Errorf("failed to fetch incidents: %w", err)
+	}
+
+	a.circuitBreaker.RecordSuccess()
+	source := "all"
+	if len(opts.TeamIDs) > 0 {
+		source = "teams"
+	}
+	a.processAndUpdateIncidents(incidents, source)
+	return nil
+}
+
+func (a *App) fetchUserIncidents() error {
+	client := a.getClient()
+	if client == nil || a.isPaused() {
+		return nil
 	}
 
 	// Check if shutdown is in progress
 	select {
 	case <-a.shutdownChan:
-		return
+		return nil
 	default:
 	}
 
 	// Check circuit breaker
 	if !a.circuitBreaker.Allow() {
 		a.logger.Warn("Circuit breaker open, skipping user fetch")
-		return
+		return fmt.Errorf("circuit breaker open")
 	}
 
 	// Get or refresh user ID with caching
@@ -1038,18 +2330,18 @@ func (a *App) fetchUserIncidents() {
 		go a.refreshUserCache()
 
 		// Try to get current user synchronously for this cycle
-		if user, err := a.client.GetCurrentUser(); err == nil {
+		if user, err := client.GetCurrentUser(); err == nil {
 			userID = user.ID
 			a.userCache.Set(userID, user)
 		} else {
 			a.logger.Error(fmt.Sprintf("Failed to get current user: %v", err))
 			a.circuitBreaker.RecordFailure()
-			return
+			return fmt.Errorf("failed to get current user: %w", err)
 		}
 	}
 
 	if userID == "" {
-		return
+		return nil
 	}
 
 	// Get previously assigned incidents BEFORE fetching new ones
@@ -1068,13 +2360,13 @@ func (a *App) fetchUserIncidents() {
 	// Pass empty slice to get ALL incidents assigned to user
 	// Don't filter by selected services - we want the UNION, not INTERSECTION
 	incidents, err := a.fetchWithRetry(func() ([]database.IncidentData, error) {
-		return a.client.FetchOpenIncidents([]string{}, userID)
+		return client.FetchOpenIncidents([]string{}, userID)
 	}, 3)
 
 	if err != nil {
 		a.logger.Error(fmt.Sprintf("Failed to fetch user incidents after retries: %v", err))
 		a.circuitBreaker.RecordFailure()
-		return
+		return fmt.Errorf("failed to fetch user incidents: %w", err)
 	}
 
 	// Track which incidents are currently assigned to this user
@@ -1102,10 +2394,9 @@ func (a *App) fetchUserIncidents() {
 			// Check if incident exists and is still open
 			if incident, err := a.db.GetIncidentByID(incidentID); err == nil {
 				if incident.Status == "triggered" || incident.Status == "acknowledged" {
-					// Mark as resolved since it's no longer assigned
-					incident.Status = "resolved"
-					incident.UpdatedAt = time.Now()
-					if err := a.db.UpsertIncident(incident); err != nil {
+					// Mark as resolved since it's no longer assigned. updated_at is
+					// kept so a later poll that still reports it open restores it.
+					if err := a.markResolvedLocally(incidentID); err != nil {
 						a.logger.Error(fmt.Sprintf("Failed to mark unassigned incident as resolved: %v", err))
 					} else {
 						a.logger.Info(fmt.Sprintf("Marked unassigned incident %s as resolved", incidentID))
@@ -1130,33 +2421,54 @@ func (a *App) fetchUserIncidents() {
 
 	a.circuitBreaker.RecordSuccess()
 	a.processAndUpdateIncidents(incidents, "user")
+	return nil
 }
 
-func (a *App) fetchResolvedIncidentsSince() {
-	if a.client == nil || !a.circuitBreaker.Allow() {
-		return
+// Resolved fetches switch to per-service concurrent fetches once this many
+// services are selected
+const (
+	resolvedFanOutThreshold  = 5
+	resolvedFetchConcurrency = 4
+)
+
+// resolvedFetchServices returns the service filter for resolved fetches and
+// whether to fetch at all. In "all services" mode the filter is empty and the
+// callers' lookback clamp is what bounds the fetch.
+func (a *App) resolvedFetchServices() ([]string, bool) {
+	if a.monitoringAll() {
+		return nil, true
+	}
+
+	a.mu.RLock()
+	selectedServices := append([]string{}, a.selectedServices...)
+	a.mu.RUnlock()
+
+	return selectedServices, len(selectedServices) > 0
+}
+
+func (a *App) fetchResolvedIncidentsSince() error {
+	client := a.getClient()
+	if client == nil || a.isPaused() || !a.circuitBreaker.Allow() {
+		return nil
 	}
 
 	// Prevent concurrent resolved fetches
 	if !a.resolvedFetchMu.TryLock() {
 		a.logger.Debug("Skipping resolved fetch - another fetch in progress")
-		return
+		return nil
 	}
 	defer a.resolvedFetchMu.Unlock()
 
 	// Check if shutdown is in progress
 	select {
 	case <-a.shutdownChan:
-		return
+		return nil
 	default:
 	}
 
-	a.mu.RLock()
-	selectedServices := append([]string{}, a.selectedServices...)
-	a.mu.RUnlock()
-
-	if len(selectedServices) == 0 {
-		return
+	selectedServices, ok := a.resolvedFetchServices()
+	if !ok {
+		return nil
 	}
 
 	// Get the latest resolved date
@@ -1166,26 +2478,35 @@ func (a *App) fetchResolvedIncidentsSince() {
 
 	now := time.Now()
 
-	// Safety check: don't go back more than 7 days
-	sevenDaysAgo := now.Add(-7 * 24 * time.Hour)
-	if since.Before(sevenDaysAgo) {
-		since = sevenDaysAgo
-		a.logger.Info("Limiting resolved fetch to 7 days for performance")
+	// Safety check: don't go back further than the configured lookback
+	lookback := a.resolvedLookbackWindow()
+	if oldest := now.Add(-lookback); since.Before(oldest) {
+		since = oldest
+		a.logger.Info(fmt.Sprintf("Limiting resolved fetch to %v for performance", lookback))
 	}
 
 	resolvedOpts := store.FetchOptions{
 		ServiceIDs: selectedServices,
+		TeamIDs:    a.teamFilterIDs(),
 		Statuses:   []string{"resolved"},
 		Since:      since,
 		Until:      now,
 	}
 
-	// Use paginated fetch with smaller page size to reduce timeout risk
-	incidents, err := a.client.FetchIncidentsWithPagination(resolvedOpts, 50)
+	// With many services a single paginated call can hit the page cap and drop
+	// incidents, so fan out per service instead
+	var incidents []database.IncidentData
+	var err error
+	if len(selectedServices) >= resolvedFanOutThreshold && len(resolvedOpts.TeamIDs) == 0 {
+		incidents, err = client.FetchResolvedIncidentsConcurrent(selectedServices, since, now, resolvedFetchConcurrency)
+	} else {
+		// Use paginated fetch with smaller page size to reduce timeout risk
+		incidents, err = client.FetchIncidentsWithPagination(resolvedOpts, 50)
+	}
 	if err != nil {
 		a.logger.Error(fmt.Sprintf("Failed to fetch resolved incidents: %v", err))
 		a.circuitBreaker.RecordFailure()
-		return
+		return fmt.Errorf("failed to fetch resolved incidents: %w", err)
 	}
 
 	a.circuitBreaker.RecordSuccess()
@@ -1193,7 +2514,7 @@ func (a *App) fetchResolvedIncidentsSince() {
 	// Check shutdown before database operations
 	select {
 	case <-a.shutdownChan:
-		return
+		return nil
 	default:
 	}
 
@@ -1204,7 +2525,7 @@ func (a *App) fetchResolvedIncidentsSince() {
 		if err := a.db.UpsertIncident(incident); err != nil {
 			if err.Error() == "sql: database is closed" {
 				a.logger.Info("Database closed, stopping resolved incident updates")
-				return
+				return nil
 			}
 			a.logger.Error(fmt.Sprintf("Failed to upsert resolved incident: %v", err))
 		} else {
@@ -1214,6 +2535,7 @@ func (a *App) fetchResolvedIncidentsSince() {
 			}
 		}
 	}
+	a.confirmResolvedIncidents(incidents)
 
 	// Update latest resolved date if newer
 	if !latestDate.IsZero() {
@@ -1221,7 +2543,7 @@ func (a *App) fetchResolvedIncidentsSince() {
 		if latestDate.After(a.latestResolvedDate) {
 			a.latestResolvedDate = latestDate
 			// Persist to database
-			if err := a.db.SetState("latest_resolved_date", latestDate.Format(time.RFC3339)); err != nil {
+			if err := a.db.SetStateTime("latest_resolved_date", latestDate); err != nil {
 				a.logger.Warn(fmt.Sprintf("Failed to persist latest resolved date: %v", err))
 			}
 		}
@@ -1229,11 +2551,13 @@ func (a *App) fetchResolvedIncidentsSince() {
 	}
 
 	runtime.EventsEmit(a.ctx, "incidents-updated", "resolved")
+	return nil
 }
 
 // New adaptive fetching method
 func (a *App) fetchResolvedIncidentsAdaptive() {
-	if a.client == nil || !a.circuitBreaker.Allow() {
+	client := a.getClient()
+	if client == nil || a.isPaused() || !a.circuitBreaker.Allow() {
 		return
 	}
 
@@ -1244,11 +2568,8 @@ func (a *App) fetchResolvedIncidentsAdaptive() {
 	default:
 	}
 
-	a.mu.RLock()
-	selectedServices := append([]string{}, a.selectedServices...)
-	a.mu.RUnlock()
-
-	if len(selectedServices) == 0 {
+	selectedServices, ok := a.resolvedFetchServices()
+	if !ok {
 		return
 	}
 
@@ -1260,6 +2581,7 @@ func (a *App) fetchResolvedIncidentsAdaptive() {
 	var since time.Time
 	now := time.Now()
 	gap := now.Sub(lastFetch)
+	lookback := a.resolvedLookbackWindow()
 
 	switch {
 	case gap <= 15*time.Minute:
@@ -1267,20 +2589,25 @@ func (a *App) fetchResolvedIncidentsAdaptive() {
 	case gap <= 48*time.Hour:
 		since = now.Add(-(gap + 30*time.Minute)) // Dynamic window with overlap
 	default:
-		since = now.Add(-72 * time.Hour) // Full safety window
+		since = now.Add(-lookback) // Full safety window
+	}
+
+	if oldest := now.Add(-lookback); since.Before(oldest) {
+		since = oldest
 	}
 
 	a.logger.Info(fmt.Sprintf("Adaptive fetch window: %v (gap: %v)", now.Sub(since), gap))
 
 	resolvedOpts := store.FetchOptions{
 		ServiceIDs: selectedServices,
+		TeamIDs:    a.teamFilterIDs(),
 		Statuses:   []string{"resolved"},
 		Since:      since,
 		Until:      now,
 	}
 
 	// Use paginated fetch ONLY for resolved incidents
-	incidents, err := a.client.FetchIncidentsWithPagination(resolvedOpts, 100)
+	incidents, err := client.FetchIncidentsWithPagination(resolvedOpts, 100)
 	if err != nil {
 		a.logger.Error(fmt.Sprintf("Failed to fetch resolved incidents: %v", err))
 		a.circuitBreaker.RecordFailure()
@@ -1306,6 +2633,7 @@ func (a *App) fetchResolvedIncidentsAdaptive() {
 			a.logger.Error(fmt.Sprintf("Failed to upsert resolved incident: %v", err))
 		}
 	}
+	a.confirmResolvedIncidents(incidents)
 
 	// Update last fetch timestamp
 	a.lastResolvedFetchMu.Lock()
@@ -1313,7 +2641,7 @@ func (a *App) fetchResolvedIncidentsAdaptive() {
 	a.lastResolvedFetchMu.Unlock()
 
 	// Persist to database
-	if err := a.db.SetState("last_resolved_fetch", now.Format(time.RFC3339)); err != nil {
+	if err := a.db.SetStateTime("last_resolved_fetch", now); err != nil {
 		if err.Error() != "sql: database is closed" {
 			a.logger.Warn(fmt.Sprintf("Failed to persist last fetch time: %v", err))
 		}
@@ -1323,7 +2651,8 @@ func (a *App) fetchResolvedIncidentsAdaptive() {
 }
 
 func (a *App) performInitialResolvedFetch() {
-	if a.client == nil {
+	client := a.getClient()
+	if client == nil || a.isPaused() {
 		return
 	}
 
@@ -1331,16 +2660,13 @@ func (a *App) performInitialResolvedFetch() {
 	a.resolvedFetchMu.Lock()
 	defer a.resolvedFetchMu.Unlock()
 
-	a.mu.RLock()
-	selectedServices := append([]string{}, a.selectedServices...)
-	a.mu.RUnlock()
-
-	if len(selectedServices) == 0 {
+	selectedServices, ok := a.resolvedFetchServices()
+	if !ok {
 		return
 	}
 
-	// Start with 3 days ago
-	since := time.Now().Add(-72 * time.Hour)
+	// Start from the configured lookback
+	since := time.Now().Add(-a.resolvedLookbackWindow())
 	until := time.Now()
 
 	a.logger.Info(fmt.Sprintf("Performing initial resolved incidents fetch from %s", since.Format(time.RFC3339)))
@@ -1352,13 +2678,14 @@ func (a *App) performInitialResolvedFetch() {
 
 	opts := store.FetchOptions{
 		ServiceIDs: selectedServices,
+		TeamIDs:    a.teamFilterIDs(),
 		Statuses:   []string{"resolved"},
 		Since:      since,
 		Until:      until,
 	}
 
 	// Use smaller page size for initial fetch
-	incidents, err := a.client.FetchIncidentsWithPagination(opts, 50)
+	incidents, err := client.FetchIncidentsWithPagination(opts, 50)
 	if err != nil {
 		a.logger.Error(fmt.Sprintf("Initial resolved fetch failed: %v", err))
 		return
@@ -1385,7 +2712,7 @@ func (a *App) performInitialResolvedFetch() {
 		a.latestResolvedMu.Unlock()
 
 		// Persist to database
-		if err := a.db.SetState("latest_resolved_date", latestDate.Format(time.RFC3339)); err != nil {
+		if err := a.db.SetStateTime("latest_resolved_date", latestDate); err != nil {
 			a.logger.Warn(fmt.Sprintf("Failed to persist initial latest resolved date: %v", err))
 		}
 	}
@@ -1394,20 +2721,180 @@ func (a *App) performInitialResolvedFetch() {
 	runtime.EventsEmit(a.ctx, "incidents-updated", "resolved")
 }
 
+// emitCircuitBreakerState tells the UI when PagerDuty requests are paused or resume
+func (a *App) emitCircuitBreakerState(state string) {
+	a.logger.Info(fmt.Sprintf("Circuit breaker state changed: %s", state))
+	runtime.EventsEmit(a.ctx, "circuit-breaker-changed", state)
+	a.updateConnectionStatus()
+}
+
+// Connection statuses reported by GetConnectionStatus
+const (
+	connectionConnected     = "connected"
+	connectionDegraded      = "degraded"
+	connectionDisconnected  = "disconnected"
+	connectionNotConfigured = "not-configured"
+)
+
+// connectionStaleMin is the shortest time without a successful fetch before
+// the connection is reported degraded; slow poll intervals extend it
+const connectionStaleMin = 2 * time.Minute
+
+// GetConnectionStatus summarizes the PagerDuty connection for the UI:
+// "not-configured" without a client, "disconnected" while the circuit breaker
+// is open, "degraded" while it is half-open, after recent failures, or when
+// fetches have stopped succeeding, and "connected" otherwise.
+func (a *App) GetConnectionStatus() string {
+	if a.getClient() == nil {
+		return connectionNotConfigured
+	}
+
+	if a.circuitBreaker != nil {
+		switch atomic.LoadInt32(&a.circuitBreaker.state) {
+		case 1:
+			return connectionDisconnected
+		case 2:
+			return connectionDegraded
+		}
+		if atomic.LoadInt32(&a.circuitBreaker.failures) > 0 {
+			return connectionDegraded
+		}
+	}
+
+	// No fetch runs while paused, so an old last fetch means nothing then
+	a.lastSuccessfulFetchMu.RLock()
+	lastFetch := a.lastSuccessfulFetch
+	a.lastSuccessfulFetchMu.RUnlock()
+	if !lastFetch.IsZero() && !a.isPaused() {
+		service, _ := a.effectivePollIntervals()
+		if time.Since(lastFetch) > max(connectionStaleMin, 3*service) {
+			return connectionDegraded
+		}
+	}
+
+	return connectionConnected
+}
+
+// updateConnectionStatus emits connection-status-changed when the connection
+// status differs from the last one emitted
+func (a *App) updateConnectionStatus() {
+	status := a.GetConnectionStatus()
+
+	a.connectionStatusMu.Lock()
+	changed := status != a.connectionStatus
+	a.connectionStatus = status
+	a.connectionStatusMu.Unlock()
+
+	if changed {
+		a.logger.Info(fmt.Sprintf("PagerDuty connection status: %s", status))
+		runtime.EventsEmit(a.ctx, "connection-status-changed", status)
+	}
+}
+
+// ForceRefresh immediately fetches open and resolved incidents instead of
+// waiting for the next poll. It still honors pause, the circuit breaker and
+// the rate limiter, and returns an error when nothing was fetched or a fetch
+// failed.
+func (a *App) ForceRefresh() error {
+	if a.getClient() == nil {
+		return fmt.Errorf("PagerDuty client not initialized")
+	}
+
+	if a.isPaused() {
+		return fmt.Errorf("polling is paused, resume it to refresh")
+	}
+
+	if !a.circuitBreaker.Allow() {
+		a.logger.Warn("Force refresh skipped: circuit breaker open")
+		return fmt.Errorf("PagerDuty requests are paused after repeated failures, try again shortly")
+	}
+
+	if !a.rateLimitTracker.CanMakeCall() {
+		a.logger.Warn("Force refresh skipped: rate limit approaching threshold")
+		return fmt.Errorf("rate limit reached, try again shortly")
+	}
+
+	a.logger.Info("Force refresh requested")
+
+	// Fetches service (and user, when filtering) incidents synchronously
+	openErr := a.fetchAndUpdateIncidents()
+	a.rateLimitTracker.RecordCall()
+
+	resolvedErr := a.fetchResolvedIncidentsSince()
+	a.rateLimitTracker.RecordCall()
+
+	// Whatever did succeed is already stored, so refresh the UI either way
+	runtime.EventsEmit(a.ctx, "incidents-updated", "both")
+
+	if err := errors.Join(openErr, resolvedErr); err != nil {
+		a.logger.Warn(fmt.Sprintf("Force refresh failed: %v", err))
+		return fmt.Errorf("refresh failed: %w", err)
+	}
+	return nil
+}
+
+// Backoff bounds for fetchWithRetry; each retry doubles the delay up to the cap
+const (
+	fetchRetryBaseDelay = 500 * time.Millisecond
+	fetchRetryMaxDelay  = 5 * time.Second
+)
+
+// fetchWithRetry calls fn, retrying transient failures up to maxRetries times
+// with exponential backoff and jitter. Auth errors and cancellation fail
+// immediately so they reach the circuit breaker without delay.
 func (a *App) fetchWithRetry(
 	fn func() ([]database.IncidentData, error),
-	maxRetries int, // parameter kept for compatibility but ignored
+	maxRetries int,
 ) ([]database.IncidentData, error) {
-	// No retries - the polling mechanism handles automatic retries
-	return fn()
+	incidents, err := fn()
+	for attempt := 0; attempt < maxRetries && err != nil && isRetryableFetchError(err); attempt++ {
+		delay := fetchRetryBaseDelay << attempt
+		if delay > fetchRetryMaxDelay {
+			delay = fetchRetryMaxDelay
+		}
+		// Up to 50% jitter so concurrent pollers don't retry in lockstep
+		delay += time.Duration(rand.Int63n(int64(delay) / 2))
+
+		a.logger.Warn(fmt.Sprintf("Fetch failed (attempt %d/%d), retrying in %v: %v", attempt+1, maxRetries+1, delay, err))
+
+		select {
+		case <-a.shutdownChan:
+			return nil, err
+		case <-time.After(delay):
+		}
+
+		if !a.waitForRateLimit(fetchRetryMaxDelay) {
+			return nil, fmt.Errorf("rate limit reached while retrying: %w", err)
+		}
+		a.rateLimitTracker.RecordCall()
+
+		incidents, err = fn()
+	}
+	return incidents, err
+}
+
+// isRetryableFetchError reports whether a fetch error is likely transient:
+// timeouts, network failures, 429s and 5xx responses
+func isRetryableFetchError(err error) bool {
+	if errors.Is(err, context.Canceled) || strings.Contains(err.Error(), "context cancelled") {
+		return false
+	}
+
+	var apiErr pagerduty.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+
+	return true
 }
 
 func (a *App) refreshUserCache() {
-	if a.client == nil {
+	client := a.getClient()
+	if client == nil {
 		return
 	}
 
-	user, err := a.client.GetCurrentUser()
+	user, err := client.GetCurrentUser()
 	if err != nil {
 		a.logger.Warn(fmt.Sprintf("Failed to refresh user cache: %v", err))
 		return
@@ -1418,73 +2905,409 @@ func (a *App) refreshUserCache() {
 }
 
 func (a *App) GetOpenIncidents(serviceIDs []string) ([]database.IncidentData, error) {
-	if a.db == nil {
-		err := fmt.Errorf("database not initialized")
-		a.logger.Error(err.Error())
-		return nil, err
-	}
+	return a.getOpenIncidents(serviceIDs, "", "")
+}
 
-	// Create a snapshot of configuration to avoid data races
+// OpenIncidentsWithCounts is the open incident list with per-incident note
+// counts for the list badges
+type OpenIncidentsWithCounts struct {
+	Incidents  []database.IncidentData `json:"incidents"`
+	NoteCounts map[string]int          `json:"note_counts"` // keyed by incident ID; missing means 0
+}
+
+// GetOpenIncidentsWithCounts returns the open incidents for the selected
+// services along with how many notes each has. Counts come from the notes
+// cache, which is filled when an incident's sidebar is opened, so incidents
+// never opened in this app report 0.
+func (a *App) GetOpenIncidentsWithCounts() (*OpenIncidentsWithCounts, error) {
 	a.mu.RLock()
-	filterByUser := a.filterByUser
-	servicesConfig := a.servicesConfig
+	selectedServices := append([]string{}, a.selectedServices...)
 	a.mu.RUnlock()
 
-	// Filter out disabled services
-	enabledServices := []string{}
-	if servicesConfig != nil {
-		for _, serviceID := range serviceIDs {
-			isDisabled := false
-			for _, service := range servicesConfig.Services {
-				// Check if this service is disabled
-				if service.Disabled {
-					switch id := service.ID.(type) {
-					case string:
-						if id == serviceID {
-							isDisabled = true
-							break
-						}
-					case []interface{}:
-						for _, sid := range id {
-							if strID, ok := sid.(string); ok && strID == serviceID {
-								isDisabled = true
-								break
-							}
-						}
-					}
-				}
-				if isDisabled {
-					break
-				}
-			}
-			if !isDisabled {
-				enabledServices = append(enabledServices, serviceID)
-			}
-		}
-	} else {
-		enabledServices = serviceIDs
+	incidents, err := a.getOpenIncidents(selectedServices, "", "")
+	if err != nil {
+		return nil, err
 	}
 
-	// Don't fetch if polling is active - just return cached data
-	a.pollMu.RLock()
-	isPolling := a.polling
-	a.pollMu.RUnlock()
-
-	// Only fetch manually if polling is not active
-	if !isPolling && a.client != nil {
-		a.fetchAndUpdateIncidents()
+	incidentIDs := make([]string, len(incidents))
+	for i, incident := range incidents {
+		incidentIDs[i] = incident.IncidentID
 	}
 
-	// Get all open incidents from database
-	allIncidents, err := a.db.GetOpenIncidents()
+	counts, err := a.db.GetNoteCountsByIncident(incidentIDs)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("Failed to get open incidents: %v", err))
-		return nil, err
+		a.logger.Error(fmt.Sprintf("Failed to count incident notes: %v", err))
+		return nil, fmt.Errorf("failed to count incident notes: %w", err)
 	}
 
-	// Get user ID for the assigned-incident set. Resolve it regardless of
-	// filterByUser so the AssignedToMe flag stays accurate even when the
-	// dropdown "Assigned" toggle is off (the union fetch keeps the set fresh).
+	return &OpenIncidentsWithCounts{Incidents: incidents, NoteCounts: counts}, nil
+}
+
+// Bounds for the SLA threshold, in minutes
+const (
+	defaultSLAThresholdMinutes = 30
+	minSLAThresholdMinutes     = 1
+	maxSLAThresholdMinutes     = 7 * 24 * 60
+)
+
+// IncidentWithMeta is an open incident with values computed at request time
+type IncidentWithMeta struct {
+	Incident    database.IncidentData `json:"incident"`
+	AgeSeconds  int64                 `json:"age_seconds"`  // time since created_at
+	SLABreached bool                  `json:"sla_breached"` // open longer than the SLA threshold
+}
+
+// SetSLAThresholdMinutes sets how long an incident may stay open before it is
+// flagged as breaching its SLA
+func (a *App) SetSLAThresholdMinutes(n int) error {
+	if n < minSLAThresholdMinutes || n > maxSLAThresholdMinutes {
+		return fmt.Errorf("SLA threshold must be between %d and %d minutes", minSLAThresholdMinutes, maxSLAThresholdMinutes)
+	}
+
+	atomic.StoreInt32(&a.slaThresholdMinutes, int32(n))
+
+	if a.db != nil {
+		if err := a.db.SetStateInt("sla_threshold_minutes", n); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist SLA threshold: %v", err))
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("SLA threshold set to %d minutes", n))
+	return nil
+}
+
+// GetSLAThresholdMinutes returns the SLA threshold in minutes
+func (a *App) GetSLAThresholdMinutes() int {
+	return int(atomic.LoadInt32(&a.slaThresholdMinutes))
+}
+
+// GetOpenIncidentsWithMeta returns the open incidents for the selected
+// services with their age and whether they breach the SLA threshold
+func (a *App) GetOpenIncidentsWithMeta() ([]IncidentWithMeta, error) {
+	a.mu.RLock()
+	selectedServices := append([]string{}, a.selectedServices...)
+	a.mu.RUnlock()
+
+	incidents, err := a.getOpenIncidents(selectedServices, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	threshold := time.Duration(atomic.LoadInt32(&a.slaThresholdMinutes)) * time.Minute
+
+	result := make([]IncidentWithMeta, 0, len(incidents))
+	for _, incident := range incidents {
+		age := now.Sub(incident.CreatedAt)
+		if age < 0 {
+			age = 0
+		}
+		result = append(result, IncidentWithMeta{
+			Incident:    incident,
+			AgeSeconds:  int64(age / time.Second),
+			SLABreached: age > threshold,
+		})
+	}
+
+	return result, nil
+}
+
+// GetOpenIncidentsFiltered is GetOpenIncidents limited to one urgency ("low" or
+// "high"), read from the local cache. An empty urgency returns all incidents.
+func (a *App) GetOpenIncidentsFiltered(serviceIDs []string, urgency string) ([]database.IncidentData, error) {
+	if urgency != "" && urgency != "low" && urgency != "high" {
+		return nil, fmt.Errorf("invalid urgency: %s", urgency)
+	}
+	return a.getOpenIncidents(serviceIDs, urgency, "")
+}
+
+// GetOpenIncidentsSorted returns open incidents like GetOpenIncidents, ordered
+// by sortBy: "status", "urgency" (high first), "created" (newest first) or
+// "alerts" (most alerts first)
+func (a *App) GetOpenIncidentsSorted(serviceIDs []string, sortBy string) ([]database.IncidentData, error) {
+	switch sortBy {
+	case "status", "urgency", "created", "alerts":
+	default:
+		return nil, fmt.Errorf("invalid sort mode: %s", sortBy)
+	}
+	return a.getOpenIncidents(serviceIDs, "", sortBy)
+}
+
+// GetIncidentsDelta returns incidents changed at or after the given RFC3339
+// timestamp, including ones that have since been resolved, so the frontend can
+// patch its list instead of re-rendering everything on each update
+func (a *App) GetIncidentsDelta(sinceRFC3339 string) ([]database.IncidentData, error) {
+	since, err := time.Parse(time.RFC3339, sinceRFC3339)
+	if err != nil {
+		return nil, fmt.Errorf("invalid since timestamp: %w", err)
+	}
+
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	incidents, err := a.db.GetIncidentsUpdatedSince(since)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to get incidents updated since %s: %v", sinceRFC3339, err))
+		return nil, fmt.Errorf("failed to get updated incidents: %w", err)
+	}
+
+	return incidents, nil
+}
+
+// filterDisabledServices drops service IDs that the config marks as disabled
+func filterDisabledServices(serviceIDs []string, servicesConfig *store.ServicesConfig) []string {
+	if servicesConfig == nil {
+		return serviceIDs
+	}
+
+	enabledServices := []string{}
+	for _, serviceID := range serviceIDs {
+		isDisabled := false
+		for _, service := range servicesConfig.Services {
+			// Check if this service is disabled
+			if service.Disabled {
+				for _, id := range configuredServiceIDs(service.ID) {
+					if id == serviceID {
+						isDisabled = true
+						break
+					}
+				}
+			}
+			if isDisabled {
+				break
+			}
+		}
+		if !isDisabled {
+			enabledServices = append(enabledServices, serviceID)
+		}
+	}
+	return enabledServices
+}
+
+// GetOpenIncidentsGrouped returns open incidents keyed by service ID, with
+// triggered incidents ahead of acknowledged ones in each group. Every enabled
+// selected service gets an entry, even when it has no open incidents, so the
+// UI can still render its section header.
+func (a *App) GetOpenIncidentsGrouped(serviceIDs []string) (map[string][]database.IncidentData, error) {
+	incidents, err := a.getOpenIncidents(serviceIDs, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.RLock()
+	servicesConfig := a.servicesConfig
+	a.mu.RUnlock()
+
+	grouped := make(map[string][]database.IncidentData)
+	for _, serviceID := range filterDisabledServices(serviceIDs, servicesConfig) {
+		grouped[serviceID] = []database.IncidentData{}
+	}
+
+	// Assigned incidents from unselected services get their own groups too
+	for _, incident := range incidents {
+		grouped[incident.ServiceID] = append(grouped[incident.ServiceID], incident)
+	}
+
+	for _, group := range grouped {
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].Status == "triggered" && group[j].Status != "triggered"
+		})
+	}
+
+	return grouped, nil
+}
+
+// GetServiceIncidentCounts returns the number of open incidents per service ID
+// for the sidebar badges. Selected services with no open incidents are
+// included with a zero count; other services appear only when non-zero.
+func (a *App) GetServiceIncidentCounts() (map[string]int, error) {
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	counts, err := a.db.GetOpenIncidentCountsByService()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to count open incidents by service: %v", err))
+		return nil, err
+	}
+
+	a.mu.RLock()
+	for _, serviceID := range a.selectedServices {
+		if _, ok := counts[serviceID]; !ok {
+			counts[serviceID] = 0
+		}
+	}
+	a.mu.RUnlock()
+
+	return counts, nil
+}
+
+// ServiceStatus describes a configured service for the settings and sidebar
+// views: its IDs, whether it is disabled or muted, and its open incident count
+type ServiceStatus struct {
+	Name                  string   `json:"name"`
+	ServiceIDs            []string `json:"service_ids"`
+	Disabled              bool     `json:"disabled"`
+	SuppressNotifications bool     `json:"suppress_notifications"`
+	OpenCount             int      `json:"open_count"`
+}
+
+// GetServicesWithStatus returns every configured service with its enabled
+// state and open incident count in one call. A service entry with several IDs
+// reports the sum of their counts.
+func (a *App) GetServicesWithStatus() ([]ServiceStatus, error) {
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	counts, err := a.db.GetOpenIncidentCountsByService()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to count open incidents by service: %v", err))
+		return nil, err
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.servicesConfig == nil {
+		return nil, fmt.Errorf("no services configuration loaded")
+	}
+
+	statuses := make([]ServiceStatus, 0, len(a.servicesConfig.Services))
+	for _, service := range a.servicesConfig.Services {
+		status := ServiceStatus{
+			Name:                  service.Name,
+			ServiceIDs:            configuredServiceIDs(service.ID),
+			Disabled:              service.Disabled,
+			SuppressNotifications: service.SuppressNotifications,
+		}
+		for _, id := range status.ServiceIDs {
+			status.OpenCount += counts[id]
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// GetIncidentsByServiceName returns incidents for a configured service, looked
+// up by its display name (case-insensitive). Every service ID of matching
+// config entries is queried. status is "open", "triggered", "acknowledged",
+// "resolved", or empty for any status; resolved matches are capped at the
+// resolved display limit.
+func (a *App) GetIncidentsByServiceName(serviceName string, status string) ([]database.IncidentData, error) {
+	serviceName = strings.TrimSpace(serviceName)
+	if serviceName == "" {
+		return nil, fmt.Errorf("service name is required")
+	}
+
+	var statuses []string
+	limit := 0
+	switch status {
+	case "":
+		limit = a.resolvedDisplayLimit()
+	case "open":
+		statuses = []string{"triggered", "acknowledged"}
+	case "triggered", "acknowledged":
+		statuses = []string{status}
+	case "resolved":
+		statuses = []string{status}
+		limit = a.resolvedDisplayLimit()
+	default:
+		return nil, fmt.Errorf("invalid status: %s", status)
+	}
+
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	a.mu.RLock()
+	if a.servicesConfig == nil {
+		a.mu.RUnlock()
+		return nil, fmt.Errorf("no services configuration loaded")
+	}
+	var serviceIDs []string
+	for _, service := range a.servicesConfig.Services {
+		if strings.EqualFold(strings.TrimSpace(service.Name), serviceName) {
+			serviceIDs = append(serviceIDs, configuredServiceIDs(service.ID)...)
+		}
+	}
+	a.mu.RUnlock()
+
+	if len(serviceIDs) == 0 {
+		return nil, fmt.Errorf("service not found: %s", serviceName)
+	}
+
+	incidents, err := a.db.GetIncidentsByServices(serviceIDs, statuses, limit)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to get incidents for service %s: %v", serviceName, err))
+		return nil, fmt.Errorf("failed to get incidents: %w", err)
+	}
+
+	return incidents, nil
+}
+
+// getOpenIncidents applies the service and assigned-mode filtering shared by
+// GetOpenIncidents and GetOpenIncidentsFiltered
+func (a *App) getOpenIncidents(serviceIDs []string, urgency, sortBy string) ([]database.IncidentData, error) {
+	if a.db == nil {
+		err := fmt.Errorf("database not initialized")
+		a.logger.Error(err.Error())
+		return nil, err
+	}
+
+	// Create a snapshot of configuration to avoid data races
+	a.mu.RLock()
+	filterByUser := a.filterByUser
+	servicesConfig := a.servicesConfig
+	a.mu.RUnlock()
+
+	// Filter out disabled services
+	enabledServices := filterDisabledServices(serviceIDs, servicesConfig)
+
+	// Don't fetch if polling is active - just return cached data
+	a.pollMu.RLock()
+	isPolling := a.polling
+	a.pollMu.RUnlock()
+
+	// Only fetch manually if polling is not active
+	if !isPolling && a.getClient() != nil {
+		a.fetchAndUpdateIncidents()
+	}
+
+	// Get all open incidents from database
+	var allIncidents []database.IncidentData
+	var err error
+	switch {
+	case urgency != "":
+		allIncidents, err = a.db.GetOpenIncidentsByUrgency(urgency)
+	case sortBy != "":
+		allIncidents, err = a.db.GetOpenIncidentsSorted(sortBy)
+	default:
+		allIncidents, err = a.db.GetOpenIncidents()
+	}
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to get open incidents: %v", err))
+		return nil, err
+	}
+
+	// In "all services" mode every enabled service counts as selected
+	if a.monitoringAll() {
+		seen := make(map[string]bool)
+		var allServiceIDs []string
+		for _, incident := range allIncidents {
+			if !seen[incident.ServiceID] {
+				seen[incident.ServiceID] = true
+				allServiceIDs = append(allServiceIDs, incident.ServiceID)
+			}
+		}
+		enabledServices = filterDisabledServices(allServiceIDs, servicesConfig)
+	}
+
+	// Get user ID for the assigned-incident set. Resolve it regardless of
+	// filterByUser so the AssignedToMe flag stays accurate even when the
+	// dropdown "Assigned" toggle is off (the union fetch keeps the set fresh).
 	var userID string
 	if a.userCache != nil {
 		cachedID, valid := a.userCache.Get()
@@ -1596,45 +3419,10 @@ func (a *App) ToggleServiceDisabled(serviceID interface{}) error {
 	for i := range a.servicesConfig.Services {
 		service := &a.servicesConfig.Services[i]
 
-		// Match service by ID
-		match := false
-		switch sid := service.ID.(type) {
-		case string:
-			if id, ok := serviceID.(string); ok && sid == id {
-				match = true
-			}
-		case []interface{}:
-			if idStr, ok := serviceID.(string); ok {
-				for _, s := range sid {
-					if str, ok := s.(string); ok && str == idStr {
-						match = true
-						break
-					}
-				}
-			} else if idArr, ok := serviceID.([]interface{}); ok {
-				// Compare arrays
-				if len(sid) == len(idArr) {
-					match = true
-					for j, v := range sid {
-						if str1, ok1 := v.(string); ok1 {
-							if str2, ok2 := idArr[j].(string); ok2 {
-								if str1 != str2 {
-									match = false
-									break
-								}
-							} else {
-								match = false
-								break
-							}
-						}
-					}
-				}
-			}
-		}
-
-		if match {
+		if serviceIDMatches(service.ID, serviceID) {
 			service.Disabled = !service.Disabled
 			a.logger.Info(fmt.Sprintf("Service %s disabled state: %v", service.Name, service.Disabled))
+			a.saveServicesConfig(a.servicesConfig)
 
 			// Trigger immediate refresh
 			go a.fetchAndUpdateIncidents()
@@ -1648,16 +3436,102 @@ func (a *App) ToggleServiceDisabled(serviceID interface{}) error {
 	return fmt.Errorf("service not found")
 }
 
+// ToggleServiceNotificationSuppression toggles whether a service's triggered
+// incidents send notifications. Unlike ToggleServiceDisabled, the incidents
+// still appear in the list.
+func (a *App) ToggleServiceNotificationSuppression(serviceID interface{}) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.servicesConfig == nil {
+		return fmt.Errorf("no services configuration loaded")
+	}
+
+	for i := range a.servicesConfig.Services {
+		service := &a.servicesConfig.Services[i]
+
+		if serviceIDMatches(service.ID, serviceID) {
+			service.SuppressNotifications = !service.SuppressNotifications
+			a.logger.Info(fmt.Sprintf("Service %s notification suppression: %v", service.Name, service.SuppressNotifications))
+			a.saveServicesConfig(a.servicesConfig)
+
+			runtime.EventsEmit(a.ctx, "services-config-updated")
+			return nil
+		}
+	}
+
+	return fmt.Errorf("service not found")
+}
+
+// serviceIDMatches reports whether a configured service ID (a string or an
+// array of strings) matches the ID passed from the frontend
+func serviceIDMatches(configID, serviceID interface{}) bool {
+	switch sid := configID.(type) {
+	case string:
+		if id, ok := serviceID.(string); ok && sid == id {
+			return true
+		}
+	case []interface{}:
+		if idStr, ok := serviceID.(string); ok {
+			for _, s := range sid {
+				if str, ok := s.(string); ok && str == idStr {
+					return true
+				}
+			}
+		} else if idArr, ok := serviceID.([]interface{}); ok {
+			// Compare arrays
+			if len(sid) == len(idArr) {
+				for j, v := range sid {
+					if str1, ok1 := v.(string); ok1 {
+						if str2, ok2 := idArr[j].(string); ok2 {
+							if str1 != str2 {
+								return false
+							}
+						} else {
+							return false
+						}
+					}
+				}
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// notificationsSuppressed reports whether the configured service containing
+// serviceID has notifications suppressed
+func (a *App) notificationsSuppressed(serviceID string) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.servicesConfig == nil {
+		return false
+	}
+
+	for _, service := range a.servicesConfig.Services {
+		if service.SuppressNotifications && serviceIDMatches(service.ID, serviceID) {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *App) GetResolvedIncidents(
 	serviceIDs []string) (
 	[]database.IncidentData, error,
 ) {
-	if a.client == nil {
+	client := a.getClient()
+	if client == nil {
 		err := fmt.Errorf("PagerDuty client not initialized")
 		a.logger.Warn(err.Error())
 		return nil, err
 	}
 
+	if a.monitoringAll() {
+		return a.getAllResolvedIncidents(client)
+	}
+
 	// Only fetch if we have services configured
 	if len(serviceIDs) == 0 {
 		a.logger.Info("No services selected, returning empty resolved incidents")
@@ -1665,7 +3539,7 @@ func (a *App) GetResolvedIncidents(
 	}
 
 	// Check if we have cached resolved incidents for these services
-	cachedIncidents, err := a.db.GetResolvedIncidentsByServices(serviceIDs)
+	cachedIncidents, err := a.db.GetResolvedIncidentsByServices(serviceIDs, a.resolvedDisplayLimit())
 	if err == nil && len(cachedIncidents) > 0 {
 		// Return cached data immediately WITHOUT spawning background fetch
 		// The regular polling will keep data updated
@@ -1677,7 +3551,7 @@ func (a *App) GetResolvedIncidents(
 	defer a.resolvedFetchMu.Unlock()
 
 	// Check again after acquiring lock (double-check pattern)
-	cachedIncidents, err = a.db.GetResolvedIncidentsByServices(serviceIDs)
+	cachedIncidents, err = a.db.GetResolvedIncidentsByServices(serviceIDs, a.resolvedDisplayLimit())
 	if err == nil && len(cachedIncidents) > 0 {
 		return cachedIncidents, nil
 	}
@@ -1689,7 +3563,7 @@ func (a *App) GetResolvedIncidents(
 		Since:      time.Now().Add(-48 * time.Hour),
 	}
 
-	incidents, err := a.client.FetchIncidentsWithPagination(opts, 50)
+	incidents, err := client.FetchIncidentsWithPagination(opts, 50)
 	if err != nil {
 		a.logger.Error(fmt.Sprintf("Failed to fetch resolved incidents: %v", err))
 		return nil, fmt.Errorf("failed to fetch resolved incidents: %w", err)
@@ -1703,404 +3577,3258 @@ func (a *App) GetResolvedIncidents(
 	}
 
 	// Return filtered incidents
-	return a.db.GetResolvedIncidentsByServices(serviceIDs)
+	return a.db.GetResolvedIncidentsByServices(serviceIDs, a.resolvedDisplayLimit())
 }
 
-// GetIncidentSidebarData fetches alerts and notes for an incident with caching and deduplication
-func (a *App) GetIncidentSidebarData(incidentID string) (*store.IncidentSidebarData, error) {
-	if incidentID == "" {
-		return nil, fmt.Errorf("incident ID is required")
+// getAllResolvedIncidents is GetResolvedIncidents for "all services" mode. An
+// empty cache is filled from the API strictly within the resolved lookback.
+func (a *App) getAllResolvedIncidents(client store.PagerDutyClient) ([]database.IncidentData, error) {
+	a.mu.RLock()
+	servicesConfig := a.servicesConfig
+	a.mu.RUnlock()
+
+	cachedIncidents, err := a.db.GetResolvedIncidents(a.resolvedDisplayLimit())
+	if err == nil && len(cachedIncidents) > 0 {
+		return dropDisabledServiceIncidents(cachedIncidents, servicesConfig), nil
 	}
 
-	if a.client == nil {
-		return nil, fmt.Errorf("PagerDuty client not initialized")
+	a.resolvedFetchMu.Lock()
+	defer a.resolvedFetchMu.Unlock()
+
+	now := time.Now()
+	opts := store.FetchOptions{
+		TeamIDs:  a.teamFilterIDs(),
+		Statuses: []string{"resolved"},
+		Since:    now.Add(-a.resolvedLookbackWindow()),
+		Until:    now,
 	}
 
-	// Prevent duplicate fetches for the same incident - KEEP THIS LOGIC
-	a.sidebarFetchingMu.Lock()
-	if a.fetchingIncidents == nil {
-		a.fetchingIncidents = make(map[string]bool)
+	incidents, err := client.FetchIncidentsWithPagination(opts, 50)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to fetch resolved incidents for all services: %v", err))
+		return nil, fmt.Errorf("failed to fetch resolved incidents: %w", err)
 	}
 
-	if a.fetchingIncidents[incidentID] {
-		a.sidebarFetchingMu.Unlock()
-		// Check database for existing data
-		dbAlerts, _ := a.db.GetIncidentAlerts(incidentID)
-		dbNotes, _ := a.db.GetIncidentNotes(incidentID)
+	for _, incident := range incidents {
+		if err := a.db.UpsertIncident(incident); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to upsert resolved incident: %v", err))
+		}
+	}
 
-		if len(dbAlerts) > 0 || len(dbNotes) > 0 {
-			// Convert database types to store types
-			alerts := convertDBToStoreAlerts(dbAlerts)
-			notes := convertDBToStoreNotes(dbNotes)
+	resolved, err := a.db.GetResolvedIncidents(a.resolvedDisplayLimit())
+	if err != nil {
+		return nil, err
+	}
+	return dropDisabledServiceIncidents(resolved, servicesConfig), nil
+}
 
-			return &store.IncidentSidebarData{
-				IncidentID: incidentID,
-				Alerts:     alerts,
-				Notes:      notes,
-				Loading:    false,
-			}, nil
+// dropDisabledServiceIncidents removes incidents from services the config
+// marks as disabled
+func dropDisabledServiceIncidents(incidents []database.IncidentData, servicesConfig *store.ServicesConfig) []database.IncidentData {
+	kept := make([]database.IncidentData, 0, len(incidents))
+	for _, incident := range incidents {
+		if len(filterDisabledServices([]string{incident.ServiceID}, servicesConfig)) > 0 {
+			kept = append(kept, incident)
 		}
-		return nil, fmt.Errorf("fetch already in progress")
 	}
+	return kept
+}
 
-	a.fetchingIncidents[incidentID] = true
-	a.sidebarFetchingMu.Unlock()
+// resolvedBackfillMaxResults caps the API fetch GetResolvedIncidentsByDateRange
+// makes when the requested range starts before the cached window
+const resolvedBackfillMaxResults = 500
 
-	// Ensure we remove the fetching flag when done
-	defer func() {
-		a.sidebarFetchingMu.Lock()
-		delete(a.fetchingIncidents, incidentID)
-		a.sidebarFetchingMu.Unlock()
-	}()
+// GetResolvedIncidentsByDateRange returns resolved incidents for the given
+// services resolved between since and until (RFC3339). When since is older
+// than the resolved lookback window, a bounded API fetch backfills the range
+// first; if that fails the cached incidents are still returned.
+func (a *App) GetResolvedIncidentsByDateRange(serviceIDs []string, sinceRFC3339, untilRFC3339 string) ([]database.IncidentData, error) {
+	since, err := time.Parse(time.RFC3339, sinceRFC3339)
+	if err != nil {
+		return nil, fmt.Errorf("invalid since timestamp: %w", err)
+	}
+	until, err := time.Parse(time.RFC3339, untilRFC3339)
+	if err != nil {
+		return nil, fmt.Errorf("invalid until timestamp: %w", err)
+	}
+	if !since.Before(until) {
+		return nil, fmt.Errorf("invalid date range: since must be before until")
+	}
 
-	// Create response object
-	response := &store.IncidentSidebarData{
-		IncidentID: incidentID,
-		Loading:    false,
-		Alerts:     []store.IncidentAlert{},
-		Notes:      []store.IncidentNote{},
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
 	}
 
-	// Fetch from database first
-	dbExistingAlerts, _ := a.db.GetIncidentAlerts(incidentID)
-	dbExistingNotes, _ := a.db.GetIncidentNotes(incidentID)
-	metadata, _ := a.db.GetSidebarMetadata(incidentID)
+	if len(serviceIDs) == 0 {
+		return []database.IncidentData{}, nil
+	}
 
-	// Convert database types to store types for existing data
-	existingAlerts := convertDBToStoreAlerts(dbExistingAlerts)
-	existingNotes := convertDBToStoreNotes(dbExistingNotes)
+	if since.Before(time.Now().Add(-a.resolvedLookbackWindow())) {
+		a.backfillResolvedRange(serviceIDs, since, until)
+	}
 
-	// Get current incident data for comparison
-	var currentIncident database.IncidentData
-	incidents, err := a.db.GetOpenIncidents()
-	if err == nil {
-		for _, inc := range incidents {
-			if inc.IncidentID == incidentID {
-				currentIncident = inc
-				break
-			}
-		}
+	incidents, err := a.db.GetResolvedIncidentsByServicesAndRange(serviceIDs, since, until, maxResolvedDisplayLimit)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to load resolved incidents in range: %v", err))
+		return nil, err
 	}
+	return incidents, nil
+}
 
-	// If no current incident found, check resolved
-	if currentIncident.IncidentID == "" {
-		resolved, err := a.db.GetResolvedIncidents()
-		if err == nil {
-			for _, inc := range resolved {
-				if inc.IncidentID == incidentID {
-					currentIncident = inc
-					break
-				}
-			}
+// backfillResolvedRange fetches resolved incidents for a range outside the
+// polled window and caches them
+func (a *App) backfillResolvedRange(serviceIDs []string, since, until time.Time) {
+	client := a.getClient()
+	if client == nil || a.isPaused() || !a.circuitBreaker.Allow() {
+		return
+	}
+
+	a.resolvedFetchMu.Lock()
+	defer a.resolvedFetchMu.Unlock()
+
+	a.logger.Info(fmt.Sprintf("Backfilling resolved incidents from %s to %s",
+		since.Format(time.RFC3339), until.Format(time.RFC3339)))
+
+	opts := store.FetchOptions{
+		ServiceIDs: serviceIDs,
+		Statuses:   []string{"resolved"},
+		Since:      since,
+		Until:      until,
+		MaxResults: resolvedBackfillMaxResults,
+	}
+	incidents, err := client.FetchIncidentsWithPagination(opts, 50)
+	if err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to backfill resolved incidents: %v", err))
+		a.circuitBreaker.RecordFailure()
+		return
+	}
+	a.circuitBreaker.RecordSuccess()
+
+	if err := a.db.BatchUpsertIncidents(incidents); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to store backfilled resolved incidents: %v", err))
+	}
+}
+
+// ResolvedIncidentsPage is one page of cached resolved incidents plus the
+// total number available, for "load more" in the resolved tab
+type ResolvedIncidentsPage struct {
+	Incidents []database.IncidentData `json:"incidents"`
+	Total     int                     `json:"total"`
+	Limit     int                     `json:"limit"`
+	Offset    int                     `json:"offset"`
+}
+
+// GetResolvedIncidentsPaged returns a page of cached resolved incidents for the
+// given services, most recently updated first. Unlike GetResolvedIncidents it
+// never calls the API; polling keeps the cache current.
+func (a *App) GetResolvedIncidentsPaged(serviceIDs []string, limit, offset int) (ResolvedIncidentsPage, error) {
+	page := ResolvedIncidentsPage{
+		Incidents: []database.IncidentData{},
+		Limit:     limit,
+		Offset:    offset,
+	}
+
+	if a.db == nil {
+		return page, fmt.Errorf("database not initialized")
+	}
+
+	if len(serviceIDs) == 0 {
+		return page, nil
+	}
+
+	incidents, total, err := a.db.GetResolvedIncidentsPaged(serviceIDs, limit, offset)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to get resolved incidents page: %v", err))
+		return page, err
+	}
+
+	if incidents != nil {
+		page.Incidents = incidents
+	}
+	page.Total = total
+	return page, nil
+}
+
+// incidentExportRow is the shape of a single incident in an export
+type incidentExportRow struct {
+	IncidentNumber int    `json:"incident_number"`
+	Title          string `json:"title"`
+	Service        string `json:"service"`
+	Status         string `json:"status"`
+	Urgency        string `json:"urgency"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+	AlertCount     int    `json:"alert_count"`
+}
+
+// ExportIncidents returns the stored incidents created between since and until
+// as a CSV or JSON document. format must be "csv" or "json". since and until are
+// RFC3339 timestamps; they are taken as strings so the frontend can pass ISO dates
+// without pulling time.Time into the generated models. An empty until means now.
+func (a *App) ExportIncidents(format string, sinceStr, untilStr string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format != "csv" && format != "json" {
+		return "", fmt.Errorf("unsupported export format %q: expected \"csv\" or \"json\"", format)
+	}
+
+	var since, until time.Time
+	var err error
+	if sinceStr != "" {
+		if since, err = time.Parse(time.RFC3339, sinceStr); err != nil {
+			return "", fmt.Errorf("invalid since timestamp: %w", err)
 		}
 	}
+	if untilStr == "" {
+		until = time.Now()
+	} else if until, err = time.Parse(time.RFC3339, untilStr); err != nil {
+		return "", fmt.Errorf("invalid until timestamp: %w", err)
+	}
+	if until.Before(since) {
+		return "", fmt.Errorf("export range is invalid: until is before since")
+	}
 
-	// Decision logic for alerts
-	shouldFetchAlerts := false
-	if len(existingAlerts) == 0 {
-		// First time fetching
-		shouldFetchAlerts = true
-	} else if metadata != nil {
-		// Check if alert count changed
-		if currentIncident.AlertCount != metadata.LastAlertCount {
-			shouldFetchAlerts = true
+	if a.db == nil {
+		return "", fmt.Errorf("database not initialized")
+	}
+
+	incidents, err := a.db.GetIncidentsInRange(since, until)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to load incidents for export: %v", err))
+		return "", fmt.Errorf("failed to load incidents: %w", err)
+	}
+
+	rows := make([]incidentExportRow, 0, len(incidents))
+	for _, incident := range incidents {
+		rows = append(rows, incidentExportRow{
+			IncidentNumber: incident.IncidentNumber,
+			Title:          incident.Title,
+			Service:        incident.ServiceSummary,
+			Status:         incident.Status,
+			Urgency:        incident.Urgency,
+			CreatedAt:      incident.CreatedAt.UTC().Format(time.RFC3339),
+			UpdatedAt:      incident.UpdatedAt.UTC().Format(time.RFC3339),
+			AlertCount:     incident.AlertCount,
+		})
+	}
+
+	a.logger.Info(fmt.Sprintf("Exporting %d incidents as %s", len(rows), format))
+
+	if format == "json" {
+		data, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to encode incidents: %w", err)
 		}
-		// Check if last fetch was more than 3 minutes ago
-		if metadata.LastFetchedAlerts != nil && time.Since(*metadata.LastFetchedAlerts) > 3*time.Minute && currentIncident.AlertCount > 0 {
-			shouldFetchAlerts = true
+		return string(data), nil
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"incident_number", "title", "service", "status", "urgency", "created_at", "updated_at", "alert_count"})
+	for _, row := range rows {
+		w.Write([]string{
+			strconv.Itoa(row.IncidentNumber),
+			row.Title,
+			row.Service,
+			row.Status,
+			row.Urgency,
+			row.CreatedAt,
+			row.UpdatedAt,
+			strconv.Itoa(row.AlertCount),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// ExportIncidentReport returns a Markdown report of one incident for
+// postmortems: its metadata, alerts, notes and, when it can be fetched, its
+// timeline. Alerts and notes come from GetIncidentSidebarData, so the cache
+// is used when fresh.
+func (a *App) ExportIncidentReport(incidentID string) (string, error) {
+	if incidentID == "" {
+		return "", fmt.Errorf("incident ID is required")
+	}
+
+	if a.db == nil {
+		return "", fmt.Errorf("database not initialized")
+	}
+
+	incident, err := a.db.GetIncidentByID(incidentID)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := a.GetIncidentSidebarData(incidentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load incident details: %w", err)
+	}
+
+	// The timeline is optional; a report without it is still useful
+	timeline, err := a.GetIncidentTimeline(incidentID)
+	if err != nil {
+		a.logger.Warn(fmt.Sprintf("Incident report for %s has no timeline: %v", incidentID, err))
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Incident #%d: %s\n\n", incident.IncidentNumber, incident.Title)
+	fmt.Fprintf(&b, "- **Service:** %s\n", a.serviceDisplayName(incident))
+	fmt.Fprintf(&b, "- **Status:** %s\n", incident.Status)
+	fmt.Fprintf(&b, "- **Urgency:** %s\n", incident.Urgency)
+	if incident.PriorityName != "" {
+		fmt.Fprintf(&b, "- **Priority:** %s\n", incident.PriorityName)
+	}
+	fmt.Fprintf(&b, "- **Created:** %s\n", incident.CreatedAt.UTC().Format(time.RFC3339))
+	if incident.AcknowledgedAt != nil {
+		fmt.Fprintf(&b, "- **Acknowledged:** %s\n", incident.AcknowledgedAt.UTC().Format(time.RFC3339))
+	}
+	if incident.ResolvedAt != nil {
+		fmt.Fprintf(&b, "- **Resolved:** %s\n", incident.ResolvedAt.UTC().Format(time.RFC3339))
+	}
+	if incident.Assignees != "" {
+		fmt.Fprintf(&b, "- **Assignees:** %s\n", incident.Assignees)
+	}
+	if incident.HTMLURL != "" {
+		fmt.Fprintf(&b, "- **Link:** %s\n", incident.HTMLURL)
+	}
+
+	fmt.Fprintf(&b, "\n## Alerts (%d)\n\n", len(data.Alerts))
+	if len(data.Alerts) == 0 {
+		b.WriteString("_No alerts._\n")
+	}
+	for _, alert := range data.Alerts {
+		fmt.Fprintf(&b, "- %s [%s] %s\n", alert.CreatedAt, alert.Status, alert.Summary)
+		if alert.Description != "" {
+			fmt.Fprintf(&b, "  - %s\n", alert.Description)
 		}
 	}
 
-	// Decision logic for notes
-	shouldFetchNotes := false
-	if len(existingNotes) == 0 {
-		// First time fetching
-		shouldFetchNotes = true
-	} else if metadata != nil {
-		// Check if incident was updated
-		if metadata.LastUpdatedAt != nil && currentIncident.UpdatedAt.After(*metadata.LastUpdatedAt) {
-			shouldFetchNotes = true
+	fmt.Fprintf(&b, "\n## Notes (%d)\n\n", len(data.Notes))
+	if len(data.Notes) == 0 {
+		b.WriteString("_No notes._\n")
+	}
+	for _, note := range data.Notes {
+		author := note.UserName
+		if author == "" {
+			author = "Unknown"
 		}
-		// Check if last fetch was more than 3 minutes ago
-		if metadata.LastFetchedNotes != nil && time.Since(*metadata.LastFetchedNotes) > 3*time.Minute {
-			shouldFetchNotes = true
+		fmt.Fprintf(&b, "### %s — %s\n\n", note.CreatedAt, author)
+		for _, response := range note.Responses {
+			fmt.Fprintf(&b, "- **%s** %s\n", response.Question, response.Answer)
+		}
+		for _, tag := range note.Tags {
+			fmt.Fprintf(&b, "- **%s:** %s\n", tag.TagName, strings.Join(tag.SelectedValues, ", "))
+		}
+		// Content is the formatted form of the structured fields, so only
+		// the freeform part is added when those were written out above
+		content := note.Content
+		if len(note.Responses) > 0 || len(note.Tags) > 0 {
+			b.WriteString("\n")
+			content = note.FreeformContent
+		}
+		if content != "" {
+			fmt.Fprintf(&b, "%s\n\n", content)
 		}
 	}
 
-	// Use existing data if no fetch needed
-	if !shouldFetchAlerts && !shouldFetchNotes {
-		response.Alerts = existingAlerts
-		response.Notes = existingNotes
-		return response, nil
+	if len(timeline) > 0 {
+		fmt.Fprintf(&b, "\n## Timeline (%d)\n\n", len(timeline))
+		for _, entry := range timeline {
+			line := fmt.Sprintf("- %s %s", entry.CreatedAt, entry.Summary)
+			if entry.Agent != "" {
+				line += fmt.Sprintf(" (%s)", entry.Agent)
+			}
+			b.WriteString(line + "\n")
+		}
 	}
 
-	// Concurrent API calls if needed
-	type alertResult struct {
-		alerts []store.IncidentAlert
-		err    error
+	a.logger.Info(fmt.Sprintf("Exported report for incident %s", incidentID))
+	return b.String(), nil
+}
+
+// OpenIncidentSummary is the open incident count shown on the dock badge and
+// tray, and the payload of the badge-count-changed event
+type OpenIncidentSummary struct {
+	Triggered            int `json:"triggered"`
+	Acknowledged         int `json:"acknowledged"`
+	HighUrgencyTriggered int `json:"high_urgency_triggered"`
+}
+
+// GetOpenIncidentSummary returns how many incidents are triggered and
+// acknowledged, with the high-urgency triggered count separately so the badge
+// can reflect severity
+func (a *App) GetOpenIncidentSummary() (*OpenIncidentSummary, error) {
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
 	}
 
-	type noteResult struct {
-		notes []store.IncidentNote
-		err   error
+	stats, err := a.db.GetIncidentStats()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incident stats: %w", err)
 	}
 
-	alertChan := make(chan alertResult, 1)
-	noteChan := make(chan noteResult, 1)
+	summary := &OpenIncidentSummary{}
+	summary.Triggered, _ = stats["triggered"].(int)
+	summary.Acknowledged, _ = stats["acknowledged"].(int)
+	summary.HighUrgencyTriggered, _ = stats["high_urgency_triggered"].(int)
+	return summary, nil
+}
 
-	// Fetch alerts if needed
-	if shouldFetchAlerts {
-		go func() {
-			alerts, err := a.client.GetIncidentAlerts(incidentID)
-			alertChan <- alertResult{alerts: alerts, err: err}
-		}()
-	} else {
-		// Use existing alerts
-		go func() {
-			alertChan <- alertResult{alerts: existingAlerts, err: nil}
-		}()
+// GetIncidentMetrics returns MTTA/MTTR statistics for incidents created in the
+// last `days` days. See database.GetIncidentMetrics for how they are approximated.
+func (a *App) GetIncidentMetrics(days int) (map[string]interface{}, error) {
+	if days <= 0 {
+		return nil, fmt.Errorf("days must be positive")
 	}
 
-	// Fetch notes if needed
-	if shouldFetchNotes {
-		go func() {
-			notes, err := a.client.GetIncidentNotes(incidentID)
-			noteChan <- noteResult{notes: notes, err: err}
-		}()
-	} else {
-		// Use existing notes
-		go func() {
-			noteChan <- noteResult{notes: existingNotes, err: nil}
-		}()
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
 	}
 
-	// Wait for both results with timeout
-	timeout := time.After(30 * time.Second)
-	var alertsReceived, notesReceived bool
-	var errors []string
-	var fetchedAlertsSuccess, fetchedNotesSuccess bool
+	since := time.Now().AddDate(0, 0, -days)
+	metrics, err := a.db.GetIncidentMetrics(since)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to compute incident metrics: %v", err))
+		return nil, fmt.Errorf("failed to compute incident metrics: %w", err)
+	}
 
-	for !alertsReceived || !notesReceived {
-		select {
-		case alertRes := <-alertChan:
-			alertsReceived = true
-			if alertRes.err != nil {
-				errors = append(errors, fmt.Sprintf("alerts: %v", alertRes.err))
-				a.logger.Error(fmt.Sprintf("Failed to fetch alerts for %s: %v", incidentID, alertRes.err))
-				// Use stale data on error
-				response.Alerts = existingAlerts
-			} else {
-				response.Alerts = alertRes.alerts
-				if shouldFetchAlerts {
-					// Convert to database types and store
-					dbAlerts := convertStoreToDBalerts(alertRes.alerts)
-					if err := a.db.StoreIncidentAlerts(incidentID, dbAlerts); err != nil {
-						a.logger.Error(fmt.Sprintf("Failed to store alerts: %v", err))
-					} else {
-						fetchedAlertsSuccess = true
-					}
-				}
-			}
+	metrics["days"] = days
+	return metrics, nil
+}
 
-		case noteRes := <-noteChan:
-			notesReceived = true
-			if noteRes.err != nil {
-				errors = append(errors, fmt.Sprintf("notes: %v", noteRes.err))
-				a.logger.Error(fmt.Sprintf("Failed to fetch notes for %s: %v", incidentID, noteRes.err))
-				// Use stale data on error
-				response.Notes = existingNotes
-			} else {
-				response.Notes = noteRes.notes
-				if shouldFetchNotes {
-					// Convert to database types and store
-					dbNotes := convertStoreToDbnotes(noteRes.notes)
-					if err := a.db.StoreIncidentNotes(incidentID, dbNotes); err != nil {
-						a.logger.Error(fmt.Sprintf("Failed to store notes: %v", err))
-					} else {
-						fetchedNotesSuccess = true
-					}
-				}
-			}
+// RefreshIncidentNotes clears the cached notes for an incident and re-fetches
+// them from the API, e.g. when a note was added outside the app. Cached alerts
+// are kept, unlike ClearIncidentSidebarCache.
+func (a *App) RefreshIncidentNotes(incidentID string) error {
+	if incidentID == "" {
+		return fmt.Errorf("incident ID is required")
+	}
 
-		case <-timeout:
-			if !alertsReceived || !notesReceived {
-				errors = append(errors, "timeout waiting for data")
-			}
-			alertsReceived = true
-			notesReceived = true
-			// Use whatever existing data we have
-			if !alertsReceived {
-				response.Alerts = existingAlerts
-			}
-			if !notesReceived {
-				response.Notes = existingNotes
+	client := a.getClient()
+	if client == nil {
+		return fmt.Errorf("PagerDuty client not initialized")
+	}
+
+	if a.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	// If the fetch below fails the notes stay cleared and unfetched, so the
+	// sidebar fetches them again on its next load
+	if err := a.db.ClearIncidentNotesCache(incidentID); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to clear notes cache for %s: %v", incidentID, err))
+		return fmt.Errorf("failed to clear notes cache: %w", err)
+	}
+
+	notes, err := client.GetIncidentNotes(incidentID)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to fetch notes for %s: %v", incidentID, err))
+		return fmt.Errorf("failed to fetch notes: %w", err)
+	}
+
+	if err := a.db.StoreIncidentNotes(incidentID, convertStoreToDbnotes(notes)); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to store notes: %v", err))
+		return fmt.Errorf("failed to store notes: %w", err)
+	}
+
+	if incident, err := a.db.GetIncidentByID(incidentID); err == nil {
+		if err := a.db.UpdateSidebarMetadata(incidentID, incident.AlertCount, incident.UpdatedAt, false, true); err != nil {
+			a.logger.Warn(fmt.Sprintf("Failed to update sidebar metadata: %v", err))
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("Refreshed %d notes for incident %s", len(notes), incidentID))
+	runtime.EventsEmit(a.ctx, "sidebar-data-updated", incidentID)
+
+	return nil
+}
+
+// logEntriesRefreshInterval is how long cached log entries are served before
+// the timeline is re-fetched
+const logEntriesRefreshInterval = 3 * time.Minute
+
+// GetIncidentTimeline returns an incident's log entries, oldest first. Cached
+// entries are used unless they are stale or the incident changed since they
+// were fetched.
+func (a *App) GetIncidentTimeline(incidentID string) ([]store.LogEntry, error) {
+	if incidentID == "" {
+		return nil, fmt.Errorf("incident ID is required")
+	}
+
+	client := a.getClient()
+	if client == nil {
+		return nil, fmt.Errorf("PagerDuty client not initialized")
+	}
+
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	cached, err := a.db.GetIncidentLogEntries(incidentID)
+	if err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to read cached log entries for %s: %v", incidentID, err))
+	}
+
+	shouldFetch := len(cached) == 0
+	if !shouldFetch {
+		metadata, _ := a.db.GetSidebarMetadata(incidentID)
+		if metadata == nil || metadata.LastFetchedLogEntries == nil {
+			shouldFetch = true
+		} else {
+			lastFetched := *metadata.LastFetchedLogEntries
+			if time.Since(lastFetched) > logEntriesRefreshInterval {
+				shouldFetch = true
+			} else if incident, err := a.db.GetIncidentByID(incidentID); err == nil && incident.UpdatedAt.After(lastFetched) {
+				shouldFetch = true
 			}
 		}
 	}
 
-	// Update metadata if any successful fetches
-	if (fetchedAlertsSuccess || fetchedNotesSuccess) && currentIncident.IncidentID != "" {
-		err := a.db.UpdateSidebarMetadata(
-			incidentID,
-			currentIncident.AlertCount,
-			currentIncident.UpdatedAt,
-			fetchedAlertsSuccess,
-			fetchedNotesSuccess,
-		)
-		if err != nil {
-			a.logger.Error(fmt.Sprintf("Failed to update sidebar metadata: %v", err))
+	if !shouldFetch {
+		return convertDBToStoreLogEntries(cached), nil
+	}
+
+	entries, err := client.GetIncidentLogEntries(incidentID)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to fetch log entries for %s: %v", incidentID, err))
+		if len(cached) > 0 {
+			return convertDBToStoreLogEntries(cached), nil
+		}
+		return nil, fmt.Errorf("failed to fetch log entries: %w", err)
+	}
+
+	if err := a.db.StoreIncidentLogEntries(incidentID, convertStoreToDBLogEntries(entries)); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to store log entries: %v", err))
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CreatedAt < entries[j].CreatedAt
+	})
+
+	a.logger.Debug(fmt.Sprintf("Fetched %d log entries for incident %s", len(entries), incidentID))
+	return entries, nil
+}
+
+// GetGroupedIncidentAlerts returns an incident's alerts grouped by normalized
+// summary, using the same cached fetch as the sidebar
+func (a *App) GetGroupedIncidentAlerts(incidentID string) ([]store.GroupedAlert, error) {
+	data, err := a.GetIncidentSidebarData(incidentID)
+	if err != nil {
+		return nil, err
+	}
+	return store.GroupAlerts(data.Alerts), nil
+}
+
+// GetIncidentSidebarDataFiltered returns the same data as GetIncidentSidebarData,
+// optionally without resolved alerts. Filtering happens after the fetch so the
+// cache keeps every alert.
+func (a *App) GetIncidentSidebarDataFiltered(incidentID string, includeResolvedAlerts bool) (*store.IncidentSidebarData, error) {
+	data, err := a.GetIncidentSidebarData(incidentID)
+	if err != nil || includeResolvedAlerts {
+		return data, err
+	}
+
+	filtered := *data
+	filtered.Alerts = make([]store.IncidentAlert, 0, len(data.Alerts))
+	for _, alert := range data.Alerts {
+		if alert.Status != "resolved" {
+			filtered.Alerts = append(filtered.Alerts, alert)
+		}
+	}
+	return &filtered, nil
+}
+
+// IncidentAlertSummary is the triggered/resolved alert breakdown for an incident
+type IncidentAlertSummary struct {
+	IncidentID string     `json:"incident_id"`
+	Triggered  int        `json:"triggered"`
+	Resolved   int        `json:"resolved"`
+	Total      int        `json:"total"`
+	FetchedAt  *time.Time `json:"fetched_at,omitempty"`
+}
+
+// GetIncidentAlertSummary returns how many of an incident's alerts are still
+// triggered versus resolved, fetching alerts first if they were never cached.
+func (a *App) GetIncidentAlertSummary(incidentID string) (*IncidentAlertSummary, error) {
+	if incidentID == "" {
+		return nil, fmt.Errorf("incident ID is required")
+	}
+
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	metadata, err := a.db.GetSidebarMetadata(incidentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sidebar metadata: %w", err)
+	}
+
+	if metadata == nil || metadata.LastFetchedAlerts == nil {
+		if _, err := a.GetIncidentSidebarData(incidentID); err != nil {
+			return nil, err
+		}
+		metadata, err = a.db.GetSidebarMetadata(incidentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sidebar metadata: %w", err)
+		}
+	}
+
+	summary := &IncidentAlertSummary{IncidentID: incidentID}
+	if metadata != nil {
+		summary.Triggered = metadata.TriggeredAlertCount
+		summary.Resolved = metadata.ResolvedAlertCount
+		summary.Total = metadata.TriggeredAlertCount + metadata.ResolvedAlertCount
+		summary.FetchedAt = metadata.LastFetchedAlerts
+	}
+	return summary, nil
+}
+
+// Bounds for how many incidents' sidebar data may be fetched from the API at
+// once, and how long a fetch with nothing cached waits for a free slot
+const (
+	defaultSidebarConcurrency = 4
+	minSidebarConcurrency     = 1
+	maxSidebarConcurrency     = 16
+	sidebarSlotWait           = 5 * time.Second
+)
+
+// SetSidebarConcurrency sets how many incidents' sidebar data may be fetched
+// from the API at once. Fetches already running keep their slots.
+func (a *App) SetSidebarConcurrency(n int) error {
+	if n < minSidebarConcurrency || n > maxSidebarConcurrency {
+		return fmt.Errorf("sidebar concurrency must be between %d and %d", minSidebarConcurrency, maxSidebarConcurrency)
+	}
+
+	a.sidebarFetchingMu.Lock()
+	a.sidebarSlots = make(chan struct{}, n)
+	a.sidebarFetchingMu.Unlock()
+
+	if a.db != nil {
+		if err := a.db.SetStateInt("sidebar_concurrency", n); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist sidebar concurrency: %v", err))
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("Sidebar fetch concurrency set to %d", n))
+	return nil
+}
+
+// GetSidebarConcurrency returns how many sidebar fetches may run at once
+func (a *App) GetSidebarConcurrency() int {
+	a.sidebarFetchingMu.Lock()
+	defer a.sidebarFetchingMu.Unlock()
+	return cap(a.sidebarSlots)
+}
+
+// acquireSidebarSlot takes a sidebar fetch slot, waiting up to wait for one
+// to free up. The returned release func gives the slot back to the channel it
+// came from, so resizing the limit doesn't strand running fetches.
+func (a *App) acquireSidebarSlot(wait time.Duration) (func(), bool) {
+	a.sidebarFetchingMu.Lock()
+	slots := a.sidebarSlots
+	a.sidebarFetchingMu.Unlock()
+
+	release := func() { <-slots }
+
+	select {
+	case slots <- struct{}{}:
+		return release, true
+	default:
+	}
+	if wait <= 0 {
+		return nil, false
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case slots <- struct{}{}:
+		return release, true
+	case <-timer.C:
+		return nil, false
+	}
+}
+
+// cachedResponders decodes the responders cached in sidebar metadata
+func cachedResponders(metadata *database.SidebarMetadata) []store.Responder {
+	if metadata == nil || metadata.Responders == "" {
+		return nil
+	}
+
+	var responders []store.Responder
+	if err := json.Unmarshal([]byte(metadata.Responders), &responders); err != nil {
+		return nil
+	}
+	return responders
+}
+
+// GetIncidentSidebarData fetches alerts and notes for an incident with caching and deduplication
+func (a *App) GetIncidentSidebarData(incidentID string) (*store.IncidentSidebarData, error) {
+	if incidentID == "" {
+		return nil, fmt.Errorf("incident ID is required")
+	}
+
+	client := a.getClient()
+	if client == nil {
+		return nil, fmt.Errorf("PagerDuty client not initialized")
+	}
+
+	// Prevent duplicate fetches for the same incident - KEEP THIS LOGIC
+	a.sidebarFetchingMu.Lock()
+	if a.fetchingIncidents == nil {
+		a.fetchingIncidents = make(map[string]bool)
+	}
+
+	if a.fetchingIncidents[incidentID] {
+		a.sidebarFetchingMu.Unlock()
+		// Check database for existing data
+		dbAlerts, _ := a.db.GetIncidentAlerts(incidentID)
+		dbNotes, _ := a.db.GetIncidentNotes(incidentID)
+
+		if len(dbAlerts) > 0 || len(dbNotes) > 0 {
+			// Convert database types to store types
+			alerts := convertDBToStoreAlerts(dbAlerts)
+			notes := convertDBToStoreNotes(dbNotes)
+			metadata, _ := a.db.GetSidebarMetadata(incidentID)
+
+			return &store.IncidentSidebarData{
+				IncidentID: incidentID,
+				Alerts:     alerts,
+				Notes:      notes,
+				Responders: cachedResponders(metadata),
+				Loading:    false,
+			}, nil
+		}
+		return nil, fmt.Errorf("fetch already in progress")
+	}
+
+	a.fetchingIncidents[incidentID] = true
+	a.sidebarFetchingMu.Unlock()
+
+	// Ensure we remove the fetching flag when done
+	defer func() {
+		a.sidebarFetchingMu.Lock()
+		delete(a.fetchingIncidents, incidentID)
+		a.sidebarFetchingMu.Unlock()
+	}()
+
+	// Create response object
+	response := &store.IncidentSidebarData{
+		IncidentID: incidentID,
+		Loading:    false,
+		Alerts:     []store.IncidentAlert{},
+		Notes:      []store.IncidentNote{},
+	}
+
+	// Fetch from database first
+	dbExistingAlerts, _ := a.db.GetIncidentAlerts(incidentID)
+	dbExistingNotes, _ := a.db.GetIncidentNotes(incidentID)
+	metadata, _ := a.db.GetSidebarMetadata(incidentID)
+
+	// Convert database types to store types for existing data
+	existingAlerts := convertDBToStoreAlerts(dbExistingAlerts)
+	existingNotes := convertDBToStoreNotes(dbExistingNotes)
+
+	// Get current incident data for comparison, whether open or resolved
+	currentIncident, err := a.db.GetIncidentByID(incidentID)
+	if err != nil {
+		currentIncident = database.IncidentData{}
+	}
+
+	// Decision logic for alerts
+	shouldFetchAlerts := false
+	if len(existingAlerts) == 0 {
+		// First time fetching
+		shouldFetchAlerts = true
+	} else if metadata != nil {
+		// Check if alert count changed
+		if currentIncident.AlertCount != metadata.LastAlertCount {
+			shouldFetchAlerts = true
+		}
+		// Check if last fetch was more than 3 minutes ago
+		if metadata.LastFetchedAlerts != nil && time.Since(*metadata.LastFetchedAlerts) > 3*time.Minute && currentIncident.AlertCount > 0 {
+			shouldFetchAlerts = true
+		}
+	}
+
+	// Decision logic for notes
+	shouldFetchNotes := false
+	if len(existingNotes) == 0 {
+		// First time fetching
+		shouldFetchNotes = true
+	} else if metadata != nil {
+		// Check if incident was updated
+		if metadata.LastUpdatedAt != nil && currentIncident.UpdatedAt.After(*metadata.LastUpdatedAt) {
+			shouldFetchNotes = true
+		}
+		// Check if last fetch was more than 3 minutes ago
+		if metadata.LastFetchedNotes != nil && time.Since(*metadata.LastFetchedNotes) > 3*time.Minute {
+			shouldFetchNotes = true
+		}
+	}
+
+	// Use existing data if no fetch needed
+	existingResponders := cachedResponders(metadata)
+	if !shouldFetchAlerts && !shouldFetchNotes {
+		response.Alerts = existingAlerts
+		response.Notes = existingNotes
+		response.Responders = existingResponders
+		return response, nil
+	}
+
+	// Limit how many incidents fetch at once. With cached data to show, don't
+	// wait for a slot; the next open will refresh it.
+	wait := sidebarSlotWait
+	if len(existingAlerts) > 0 || len(existingNotes) > 0 {
+		wait = 0
+	}
+	release, ok := a.acquireSidebarSlot(wait)
+	if !ok {
+		if wait == 0 {
+			a.logger.Debug(fmt.Sprintf("Sidebar fetch limit reached, serving cached data for %s", incidentID))
+			response.Alerts = existingAlerts
+			response.Notes = existingNotes
+			response.Responders = existingResponders
+			return response, nil
+		}
+		return nil, fmt.Errorf("too many sidebar fetches in progress, try again")
+	}
+	defer release()
+
+	// Concurrent API calls if needed
+	type alertResult struct {
+		alerts []store.IncidentAlert
+		err    error
+	}
+
+	type noteResult struct {
+		notes []store.IncidentNote
+		err   error
+	}
+
+	type responderResult struct {
+		responders []store.Responder
+		err        error
+	}
+
+	alertChan := make(chan alertResult, 1)
+	noteChan := make(chan noteResult, 1)
+	responderChan := make(chan responderResult, 1)
+
+	// Fetch alerts if needed
+	if shouldFetchAlerts {
+		go func() {
+			alerts, err := client.GetIncidentAlerts(incidentID)
+			alertChan <- alertResult{alerts: alerts, err: err}
+		}()
+	} else {
+		// Use existing alerts
+		go func() {
+			alertChan <- alertResult{alerts: existingAlerts, err: nil}
+		}()
+	}
+
+	// Fetch notes if needed
+	if shouldFetchNotes {
+		go func() {
+			notes, err := client.GetIncidentNotes(incidentID)
+			noteChan <- noteResult{notes: notes, err: err}
+		}()
+	} else {
+		// Use existing notes
+		go func() {
+			noteChan <- noteResult{notes: existingNotes, err: nil}
+		}()
+	}
+
+	// Responders are refreshed whenever we go to the API; the last result is
+	// cached for the opens in between
+	go func() {
+		responders, err := client.GetIncidentResponders(incidentID)
+		responderChan <- responderResult{responders: responders, err: err}
+	}()
+
+	// Wait for all results with timeout
+	timeout := time.After(30 * time.Second)
+	var alertsReceived, notesReceived, respondersReceived bool
+	var errors []string
+	var fetchedAlertsSuccess, fetchedNotesSuccess bool
+
+	for !alertsReceived || !notesReceived || !respondersReceived {
+		select {
+		case alertRes := <-alertChan:
+			alertsReceived = true
+			if alertRes.err != nil {
+				errors = append(errors, fmt.Sprintf("alerts: %v", alertRes.err))
+				a.logger.Error(fmt.Sprintf("Failed to fetch alerts for %s: %v", incidentID, alertRes.err))
+				// Use stale data on error
+				response.Alerts = existingAlerts
+			} else {
+				response.Alerts = alertRes.alerts
+				if shouldFetchAlerts {
+					// Convert to database types and store
+					dbAlerts := convertStoreToDBalerts(alertRes.alerts)
+					if err := a.db.StoreIncidentAlerts(incidentID, dbAlerts); err != nil {
+						a.logger.Error(fmt.Sprintf("Failed to store alerts: %v", err))
+					} else {
+						fetchedAlertsSuccess = true
+					}
+				}
+			}
+
+		case noteRes := <-noteChan:
+			notesReceived = true
+			if noteRes.err != nil {
+				errors = append(errors, fmt.Sprintf("notes: %v", noteRes.err))
+				a.logger.Error(fmt.Sprintf("Failed to fetch notes for %s: %v", incidentID, noteRes.err))
+				// Use stale data on error
+				response.Notes = existingNotes
+			} else {
+				response.Notes = noteRes.notes
+				if shouldFetchNotes {
+					// Convert to database types and store
+					dbNotes := convertStoreToDbnotes(noteRes.notes)
+					if err := a.db.StoreIncidentNotes(incidentID, dbNotes); err != nil {
+						a.logger.Error(fmt.Sprintf("Failed to store notes: %v", err))
+					} else {
+						fetchedNotesSuccess = true
+					}
+				}
+			}
+
+		case responderRes := <-responderChan:
+			respondersReceived = true
+			if responderRes.err != nil {
+				// Responders are secondary; keep the cached list and don't
+				// mark the sidebar as errored
+				a.logger.Warn(fmt.Sprintf("Failed to fetch responders for %s: %v", incidentID, responderRes.err))
+				response.Responders = existingResponders
+			} else {
+				response.Responders = responderRes.responders
+				respondersJSON, _ := json.Marshal(responderRes.responders)
+				if err := a.db.StoreSidebarResponders(incidentID, string(respondersJSON)); err != nil {
+					a.logger.Warn(fmt.Sprintf("Failed to store responders: %v", err))
+				}
+			}
+
+		case <-timeout:
+			if !alertsReceived || !notesReceived {
+				errors = append(errors, "timeout waiting for data")
+			}
+			if !respondersReceived {
+				response.Responders = existingResponders
+			}
+			alertsReceived = true
+			notesReceived = true
+			respondersReceived = true
+			// Use whatever existing data we have
+			if !alertsReceived {
+				response.Alerts = existingAlerts
+			}
+			if !notesReceived {
+				response.Notes = existingNotes
+			}
+		}
+	}
+
+	// Update metadata if any successful fetches
+	if (fetchedAlertsSuccess || fetchedNotesSuccess) && currentIncident.IncidentID != "" {
+		err := a.db.UpdateSidebarMetadata(
+			incidentID,
+			currentIncident.AlertCount,
+			currentIncident.UpdatedAt,
+			fetchedAlertsSuccess,
+			fetchedNotesSuccess,
+		)
+		if err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to update sidebar metadata: %v", err))
+		}
+	}
+
+	// Set error if any occurred
+	if len(errors) > 0 {
+		response.Error = strings.Join(errors, "; ")
+	}
+
+	return response, nil
+}
+
+func convertDBToStoreAlerts(dbAlerts []database.SidebarAlert) []store.IncidentAlert {
+	alerts := make([]store.IncidentAlert, len(dbAlerts))
+	for i, dbAlert := range dbAlerts {
+		alert := store.IncidentAlert{
+			ID:          dbAlert.ID,
+			Summary:     dbAlert.Summary,
+			Status:      dbAlert.Status,
+			CreatedAt:   dbAlert.CreatedAt,
+			ServiceName: dbAlert.ServiceName,
+			Description: dbAlert.Description,
+			Links:       []store.AlertLink{},
+		}
+
+		// Deserialize links from JSON
+		if dbAlert.Links != "" {
+			json.Unmarshal([]byte(dbAlert.Links), &alert.Links)
+		}
+
+		alerts[i] = alert
+	}
+	return alerts
+}
+
+// Helper function to convert store alerts to database alerts
+func convertStoreToDBalerts(storeAlerts []store.IncidentAlert) []database.SidebarAlert {
+	dbAlerts := make([]database.SidebarAlert, len(storeAlerts))
+	for i, storeAlert := range storeAlerts {
+		// Serialize links to JSON
+		linksJSON, _ := json.Marshal(storeAlert.Links)
+
+		dbAlerts[i] = database.SidebarAlert{
+			ID:          storeAlert.ID,
+			Summary:     storeAlert.Summary,
+			Status:      storeAlert.Status,
+			CreatedAt:   storeAlert.CreatedAt,
+			ServiceName: storeAlert.ServiceName,
+			Description: storeAlert.Description,
+			Links:       string(linksJSON),
+		}
+	}
+	return dbAlerts
+}
+
+// Helper function to convert database notes to store notes
+func convertDBToStoreNotes(dbNotes []database.SidebarNote) []store.IncidentNote {
+	notes := make([]store.IncidentNote, len(dbNotes))
+	for i, dbNote := range dbNotes {
+		note := store.IncidentNote{
+			ID:              dbNote.ID,
+			Content:         dbNote.Content,
+			CreatedAt:       dbNote.CreatedAt,
+			UserName:        dbNote.UserName,
+			ServiceID:       dbNote.ServiceID,
+			FreeformContent: dbNote.FreeformContent,
+		}
+
+		// Deserialize responses from JSON
+		if dbNote.Responses != "" {
+			var responses []store.NoteResponse
+			if err := json.Unmarshal([]byte(dbNote.Responses), &responses); err == nil {
+				note.Responses = responses
+			}
+		}
+
+		// Deserialize tags from JSON
+		if dbNote.Tags != "" {
+			var tags []store.NoteTag
+			if err := json.Unmarshal([]byte(dbNote.Tags), &tags); err == nil {
+				note.Tags = tags
+			}
+		}
+
+		notes[i] = note
+	}
+	return notes
+}
+
+// Helper function to convert database log entries to store log entries
+func convertDBToStoreLogEntries(dbEntries []database.SidebarLogEntry) []store.LogEntry {
+	entries := make([]store.LogEntry, len(dbEntries))
+	for i, e := range dbEntries {
+		entries[i] = store.LogEntry{
+			ID:        e.ID,
+			Type:      e.Type,
+			Summary:   e.Summary,
+			CreatedAt: e.CreatedAt,
+			Agent:     e.Agent,
+		}
+	}
+	return entries
+}
+
+// Helper function to convert store log entries to database log entries
+func convertStoreToDBLogEntries(storeEntries []store.LogEntry) []database.SidebarLogEntry {
+	dbEntries := make([]database.SidebarLogEntry, len(storeEntries))
+	for i, e := range storeEntries {
+		dbEntries[i] = database.SidebarLogEntry{
+			ID:        e.ID,
+			Type:      e.Type,
+			Summary:   e.Summary,
+			CreatedAt: e.CreatedAt,
+			Agent:     e.Agent,
+		}
+	}
+	return dbEntries
+}
+
+// Helper function to convert store notes to database notes
+func convertStoreToDbnotes(storeNotes []store.IncidentNote) []database.SidebarNote {
+	dbNotes := make([]database.SidebarNote, len(storeNotes))
+	for i, storeNote := range storeNotes {
+		dbNote := database.SidebarNote{
+			ID:              storeNote.ID,
+			Content:         storeNote.Content,
+			CreatedAt:       storeNote.CreatedAt,
+			UserName:        storeNote.UserName,
+			ServiceID:       storeNote.ServiceID,
+			FreeformContent: storeNote.FreeformContent,
+		}
+
+		// Serialize responses to JSON
+		if len(storeNote.Responses) > 0 {
+			if responsesJSON, err := json.Marshal(storeNote.Responses); err == nil {
+				dbNote.Responses = string(responsesJSON)
+			}
+		}
+
+		// Serialize tags to JSON
+		if len(storeNote.Tags) > 0 {
+			if tagsJSON, err := json.Marshal(storeNote.Tags); err == nil {
+				dbNote.Tags = string(tagsJSON)
+			}
+		}
+
+		dbNotes[i] = dbNote
+	}
+	return dbNotes
+}
+
+func (a *App) GetServiceConfigByServiceID(serviceID string) (*store.ServiceConfig, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.servicesConfig == nil {
+		return nil, fmt.Errorf("no services configuration loaded")
+	}
+
+	for _, service := range a.servicesConfig.Services {
+		for _, id := range configuredServiceIDs(service.ID) {
+			if id == serviceID {
+				return &service, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("service not found: %s", serviceID)
+}
+
+// FindIncidentsByNoteTag returns the incidents that have a note tagged with
+// tagName and value (any value when empty), most recently noted first
+func (a *App) FindIncidentsByNoteTag(tagName, value string) ([]database.IncidentData, error) {
+	if strings.TrimSpace(tagName) == "" {
+		return nil, fmt.Errorf("tag name is required")
+	}
+
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	notes, err := a.db.SearchNotesByTag(tagName, value)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to search notes by tag %s=%s: %v", tagName, value, err))
+		return nil, fmt.Errorf("failed to search notes: %w", err)
+	}
+
+	incidents := []database.IncidentData{}
+	seen := make(map[string]bool)
+	for _, note := range notes {
+		if seen[note.IncidentID] {
+			continue
+		}
+		seen[note.IncidentID] = true
+
+		incident, err := a.db.GetIncidentByID(note.IncidentID)
+		if err != nil {
+			// Notes can outlive their incident row when cleanup races a fetch
+			a.logger.Debug(fmt.Sprintf("Skipping note %s: %v", note.ID, err))
+			continue
+		}
+		incidents = append(incidents, incident)
+	}
+
+	return incidents, nil
+}
+
+// GetNoteTemplate returns the note questions and tags configured for a service.
+// Services without a Types section, or not in the config at all, get an empty
+// template so the frontend falls back to a freeform note.
+func (a *App) GetNoteTemplate(serviceID string) (*store.ServiceTypes, error) {
+	if serviceID == "" {
+		return nil, fmt.Errorf("service ID is required")
+	}
+
+	service, err := a.GetServiceConfigByServiceID(serviceID)
+	if err != nil || service.Types == nil {
+		return &store.ServiceTypes{Questions: []string{}, Tags: []store.TagConfig{}}, nil
+	}
+
+	return service.Types, nil
+}
+
+func (a *App) cleanupOldSidebarData() {
+	ticker := time.NewTicker(24 * time.Hour) // Run daily
+	defer ticker.Stop()
+
+	a.shutdownWg.Add(1)
+	defer a.shutdownWg.Done()
+
+	for {
+		select {
+		case <-a.shutdownChan:
+			a.logger.Info("Cleanup routine stopped by shutdown signal")
+			return
+		case <-ticker.C:
+			// Clean up sidebar data older than 30 days
+			sidebarCutoff := time.Now().Add(-30 * 24 * time.Hour)
+			if err := a.db.CleanupOldSidebarData(sidebarCutoff); err != nil {
+				a.logger.Error(fmt.Sprintf("Failed to cleanup old sidebar data: %v", err))
+			} else {
+				a.logger.Info("Successfully cleaned up old sidebar data")
+			}
+
+			// Clean up resolved incidents past the retention period
+			a.cleanupOldResolvedIncidents()
+		}
+	}
+}
+
+// defaultIncidentRetentionDays is how long resolved incidents are kept unless
+// changed with SetIncidentRetentionDays
+const defaultIncidentRetentionDays = 90
+
+// incidentRetentionDays returns the saved retention period for resolved incidents
+func (a *App) incidentRetentionDays() int {
+	if a.db != nil {
+		if days, err := a.db.GetStateInt("incident_retention_days"); err == nil && days > 0 {
+			return days
+		}
+	}
+	return defaultIncidentRetentionDays
+}
+
+// cleanupOldResolvedIncidents deletes resolved incidents older than the retention period
+func (a *App) cleanupOldResolvedIncidents() {
+	days := a.incidentRetentionDays()
+	cutoff := time.Now().AddDate(0, 0, -days)
+	deleted, err := a.db.CleanupOldResolvedIncidents(cutoff)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to cleanup old resolved incidents: %v", err))
+		return
+	}
+	a.logger.Info(fmt.Sprintf("Cleaned up %d resolved incidents older than %d days", deleted, days))
+}
+
+// SetIncidentRetentionDays sets how many days resolved incidents are kept
+func (a *App) SetIncidentRetentionDays(days int) error {
+	if days <= 0 {
+		return fmt.Errorf("retention days must be positive")
+	}
+
+	if a.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	if err := a.db.SetStateInt("incident_retention_days", days); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to persist incident retention: %v", err))
+		return err
+	}
+
+	a.logger.Info(fmt.Sprintf("Incident retention set to %d days", days))
+	return nil
+}
+
+// databaseDiskSize returns the size of the database file plus its -wal file,
+// which holds recent writes in WAL mode until they are checkpointed
+func databaseDiskSize(path string) int64 {
+	var size int64
+	for _, file := range []string{path, path + "-wal"} {
+		if info, err := os.Stat(file); err == nil {
+			size += info.Size()
+		}
+	}
+	return size
+}
+
+// CompactDatabase vacuums the database and returns the number of bytes freed
+func (a *App) CompactDatabase() (int64, error) {
+	if a.db == nil {
+		return 0, fmt.Errorf("database not initialized")
+	}
+
+	sizeBefore := databaseDiskSize(a.db.Path())
+
+	a.logger.Info(fmt.Sprintf("Compacting database (%d bytes)...", sizeBefore))
+	start := time.Now()
+
+	if err := a.db.Vacuum(); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to compact database: %v", err))
+		return 0, err
+	}
+
+	sizeAfter := databaseDiskSize(a.db.Path())
+
+	freed := sizeBefore - sizeAfter
+	if freed < 0 {
+		freed = 0
+	}
+
+	a.logger.Info(fmt.Sprintf("Database compacted in %v: %d -> %d bytes (freed %d)",
+		time.Since(start), sizeBefore, sizeAfter, freed))
+	return freed, nil
+}
+
+// GetIncidentRetentionDays returns how many days resolved incidents are kept
+func (a *App) GetIncidentRetentionDays() int {
+	return a.incidentRetentionDays()
+}
+
+// settingsExportVersion is bumped when the AppSettings format changes incompatibly
+const settingsExportVersion = 1
+
+// AppSettings is the portable subset of settings moved between machines with
+// ExportSettings and ImportSettings. API keys are never included.
+type AppSettings struct {
+	Version               int                 `json:"version"`
+	PollingIntervals      map[string]int      `json:"polling_intervals,omitempty"`
+	ResolvedLookbackHours int                 `json:"resolved_lookback_hours,omitempty"`
+	Notification          *NotificationConfig `json:"notification,omitempty"`
+	RetentionDays         int                 `json:"retention_days,omitempty"`
+	LogLevel              string              `json:"log_level,omitempty"`
+	Theme                 string              `json:"theme,omitempty"`
+	SelectedServices      []string            `json:"selected_services,omitempty"`
+}
+
+// ExportSettings returns the current settings as JSON for ImportSettings on
+// another machine: polling intervals, notification config (including quiet
+// hours), retention, log level, theme and selected services
+func (a *App) ExportSettings() (string, error) {
+	settings := AppSettings{
+		Version:               settingsExportVersion,
+		PollingIntervals:      a.GetPollingIntervals(),
+		ResolvedLookbackHours: a.GetResolvedLookbackHours(),
+		RetentionDays:         a.GetIncidentRetentionDays(),
+		LogLevel:              a.GetLogLevel(),
+		Theme:                 a.GetTheme(),
+		SelectedServices:      a.GetSelectedServices(),
+	}
+	if a.notificationMgr != nil {
+		cfg := a.notificationMgr.GetConfig()
+		// A snooze is tied to this moment on this machine
+		cfg.Snoozed = false
+		cfg.SnoozeUntil = time.Time{}
+		settings.Notification = &cfg
+	}
+
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode settings: %w", err)
+	}
+	return string(data), nil
+}
+
+// ImportSettings applies settings produced by ExportSettings. Each setting goes
+// through its regular setter so validation, persistence and side effects such
+// as restarting poll tickers happen as usual. Settings missing from the JSON
+// are left unchanged.
+func (a *App) ImportSettings(jsonData string) error {
+	decoder := json.NewDecoder(strings.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+
+	var settings AppSettings
+	if err := decoder.Decode(&settings); err != nil {
+		return fmt.Errorf("invalid settings JSON: %w", err)
+	}
+	if settings.Version != settingsExportVersion {
+		return fmt.Errorf("unsupported settings version: %d", settings.Version)
+	}
+
+	var errs []string
+	apply := func(name string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+
+	if p := settings.PollingIntervals; p != nil {
+		apply("polling intervals", a.SetPollingIntervals(p["service"], p["user"], p["resolved"]))
+	}
+	if settings.ResolvedLookbackHours != 0 {
+		apply("resolved lookback", a.SetResolvedLookbackHours(settings.ResolvedLookbackHours))
+	}
+	if settings.RetentionDays != 0 {
+		apply("retention", a.SetIncidentRetentionDays(settings.RetentionDays))
+	}
+	if settings.LogLevel != "" {
+		apply("log level", a.SetLogLevel(settings.LogLevel))
+	}
+	if settings.Theme != "" {
+		apply("theme", a.SetTheme(settings.Theme))
+	}
+	if cfg := settings.Notification; cfg != nil {
+		a.SetNotificationEnabled(cfg.Enabled)
+		a.SetBrowserRedirect(cfg.BrowserRedirect)
+		a.SetNotifyOnResolve(cfg.NotifyOnResolve)
+		if cfg.Sound != "" {
+			a.SetNotificationSound(cfg.Sound)
+		}
+		if cfg.MinUrgency != "" {
+			apply("notification urgency", a.SetNotificationMinUrgency(cfg.MinUrgency))
+		}
+		if cfg.QuietHoursStart != "" || cfg.QuietHoursEnd != "" {
+			apply("quiet hours", a.SetQuietHours(cfg.QuietHoursStart, cfg.QuietHoursEnd, cfg.QuietHoursEnabled))
+		}
+		apply("notification templates", a.SetNotificationTemplates(cfg.TitleTemplate, cfg.MessageTemplate))
+	}
+	if settings.SelectedServices != nil {
+		a.SetSelectedServices(settings.SelectedServices)
+	}
+
+	if len(errs) > 0 {
+		a.logger.Warn(fmt.Sprintf("Settings imported with errors: %s", strings.Join(errs, "; ")))
+		return fmt.Errorf("some settings were not applied: %s", strings.Join(errs, "; "))
+	}
+
+	a.logger.Info("Settings imported")
+	return nil
+}
+
+// to fetch user on startup
+func (a *App) ConfigureAPIKey(
+	apiKey string) error {
+	if apiKey == "" {
+		return fmt.Errorf("API key cannot be empty")
+	}
+
+	// Initialize PagerDuty client with queue
+	client, err := store.NewClient(apiKey)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to create PagerDuty client: %v", err))
+		return fmt.Errorf("failed to create PagerDuty client: %w", err)
+	}
+
+	// Set custom logger for the client
+	if a.logger != nil {
+		client.SetLogger(func(msg string) {
+			a.logger.Info(msg)
+		})
+	}
+
+	// Apply any user-configured rate limit and timeouts to the new client
+	if maxCalls := a.savedRateLimit(); maxCalls > 0 {
+		client.SetMaxCallsPerMinute(maxCalls)
+	}
+	a.applySavedAPITimeouts(client)
+	a.applySavedMaxOpenResults(client)
+
+	// Test the API key by getting current user and cache the user ID
+	user, err := client.GetCurrentUser()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to validate API key: %v", err))
+		client.Shutdown()
+		return fmt.Errorf("invalid API key: %w", err)
+	}
+
+	// Save to keyring under the active profile if available
+	if a.kr != nil {
+		profile := a.activeProfile()
+		if err := a.kr.Set(keyring.Item{
+			Key:  apiKeyItemKey(profile),
+			Data: []byte(apiKey),
+		}); err != nil {
+			a.logger.Warn(fmt.Sprintf("Failed to save API key to keyring: %v", err))
+		} else if err := a.addProfileName(profile); err != nil {
+			a.logger.Warn(fmt.Sprintf("Failed to record profile %s: %v", profile, err))
+		}
+	}
+
+	// Update client, stopping the previous one's queue
+	a.setClient(client)
+	a.logger.Info("API key configured successfully")
+
+	// Initialize components if not already done
+	if a.userCache == nil {
+		a.userCache = NewUserCache()
+	}
+	if a.circuitBreaker == nil {
+		a.initCircuitBreaker()
+	}
+	if a.rateLimitTracker == nil {
+		a.rateLimitTracker = NewRateLimitTracker()
+	}
+	if a.onCallCache == nil {
+		a.onCallCache = NewOnCallCache()
+	} else {
+		// On-calls belong to the previous account
+		a.onCallCache.Invalidate()
+	}
+	if a.userDirectory == nil {
+		a.userDirectory = NewUserDirectoryCache()
+	} else {
+		a.userDirectory.Invalidate()
+	}
+
+	// Cache the user ID immediately
+	a.userCache.Set(user.ID, user)
+	a.logger.Info(fmt.Sprintf("Cached user ID: %s", user.ID))
+
+	// Start polling cycles
+	a.StartPolling()
+	a.StartUserPolling()
+	a.StartResolvedPolling()
+
+	// Emit event to notify UI
+	runtime.EventsEmit(a.ctx, "api-key-configured")
+
+	return nil
+}
+
+func (a *App) GetAPIKey() (
+	string, error,
+) {
+	if a.kr == nil {
+		return "", fmt.Errorf("keyring not available")
+	}
+
+	item, err := a.kr.Get(apiKeyItemKey(a.activeProfile()))
+	if err != nil {
+		// Fall back to the key saved before profiles existed
+		item, err = a.kr.Get(legacyAPIKeyItem)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return string(item.Data), nil
+}
+
+// keyringPassphraseEnv sets the passphrase of the file keyring fallback
+const keyringPassphraseEnv = "PAGEROPS_KEYRING_PASSPHRASE"
+
+// keyringBackendFileInsecure is reported instead of "file" when the file
+// keyring uses the derived passphrase because keyringPassphraseEnv is unset
+const keyringBackendFileInsecure = "file-insecure"
+
+// openKeyring opens the OS keyring, or an encrypted file keyring in dataDir
+// when none of the OS backends work, and returns the backend in use
+func (a *App) openKeyring(dataDir string) (keyring.Keyring, string, error) {
+	// Try each OS backend separately so we know which one is active
+	for _, backend := range keyring.AvailableBackends() {
+		if backend == keyring.FileBackend {
+			continue
+		}
+		kr, err := keyring.Open(keyring.Config{
+			ServiceName:     "PagerOps",
+			AllowedBackends: []keyring.BackendType{backend},
+		})
+		if err == nil {
+			return kr, string(backend), nil
+		}
+		a.logger.Debug(fmt.Sprintf("Keyring backend %s unavailable: %v", backend, err))
+	}
+	a.logger.Warn("No OS keyring available, falling back to encrypted file keyring")
+
+	passphrase, derived := fileKeyringPassphrase()
+	kr, err := keyring.Open(keyring.Config{
+		ServiceName:      "PagerOps",
+		AllowedBackends:  []keyring.BackendType{keyring.FileBackend},
+		FileDir:          filepath.Join(dataDir, "keyring"),
+		FilePasswordFunc: keyring.FixedStringPrompt(passphrase),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if derived {
+		a.logger.Warn(fmt.Sprintf("%s is not set, so the file keyring passphrase is derived from the host and user; anyone who can read %s can decrypt stored API keys",
+			keyringPassphraseEnv, filepath.Join(dataDir, "keyring")))
+		return kr, keyringBackendFileInsecure, nil
+	}
+	return kr, string(keyring.FileBackend), nil
+}
+
+// fileKeyringPassphrase returns the passphrase for the file keyring and
+// whether it was derived from the host and user because
+// PAGEROPS_KEYRING_PASSPHRASE is unset. A derived passphrase is predictable,
+// so it keeps the key out of plain text but does not protect it.
+func fileKeyringPassphrase() (string, bool) {
+	if passphrase := os.Getenv(keyringPassphraseEnv); passphrase != "" {
+		return passphrase, false
+	}
+	hostname, _ := os.Hostname()
+	homeDir, _ := os.UserHomeDir()
+	return fmt.Sprintf("pager-ops:%s:%s", hostname, homeDir), true
+}
+
+// GetKeyringBackend returns the keyring backend holding API keys (e.g.
+// "keychain", "secret-service" or "file"), "file-insecure" for the file
+// keyring with a derived passphrase, or "none" when unavailable
+func (a *App) GetKeyringBackend() string {
+	if a.kr == nil {
+		return "none"
+	}
+	return a.keyringBackend
+}
+
+// legacyAPIKeyItem is the keyring key used before profile support
+const legacyAPIKeyItem = "pagerduty-api-key"
+
+// defaultProfile is the profile the legacy API key is migrated into
+const defaultProfile = "default"
+
+// apiKeyItemKey returns the keyring key holding a profile's API key
+func apiKeyItemKey(profile string) string {
+	return legacyAPIKeyItem + "-" + profile
+}
+
+// activeProfile returns the name of the selected profile
+func (a *App) activeProfile() string {
+	if a.db != nil {
+		if value, err := a.db.GetState("active_profile"); err == nil && value != "" {
+			return value
+		}
+	}
+	return defaultProfile
+}
+
+// ListProfiles returns the configured profile names
+func (a *App) ListProfiles() []string {
+	profiles := []string{}
+	if a.db == nil {
+		return profiles
+	}
+
+	value, err := a.db.GetState("profiles")
+	if err != nil || value == "" {
+		return profiles
+	}
+	if err := json.Unmarshal([]byte(value), &profiles); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to parse saved profiles: %v", err))
+		return []string{}
+	}
+	return profiles
+}
+
+// GetActiveProfile returns the name of the profile currently in use
+func (a *App) GetActiveProfile() string {
+	return a.activeProfile()
+}
+
+// addProfileName records a profile name in the saved profile list
+func (a *App) addProfileName(name string) error {
+	if a.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	profiles := a.ListProfiles()
+	for _, p := range profiles {
+		if p == name {
+			return nil
+		}
+	}
+
+	data, err := json.Marshal(append(profiles, name))
+	if err != nil {
+		return err
+	}
+	return a.db.SetState("profiles", string(data))
+}
+
+// migrateLegacyAPIKey copies the single pre-profile API key into the default
+// profile on first launch after upgrading. The legacy item is left in place.
+func (a *App) migrateLegacyAPIKey() {
+	if a.kr == nil || a.db == nil || len(a.ListProfiles()) > 0 {
+		return
+	}
+
+	item, err := a.kr.Get(legacyAPIKeyItem)
+	if err != nil || len(item.Data) == 0 {
+		return
+	}
+
+	if err := a.kr.Set(keyring.Item{
+		Key:  apiKeyItemKey(defaultProfile),
+		Data: item.Data,
+	}); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to migrate API key to default profile: %v", err))
+		return
+	}
+	if err := a.addProfileName(defaultProfile); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to record default profile: %v", err))
+		return
+	}
+	if err := a.db.SetState("active_profile", defaultProfile); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to set active profile: %v", err))
+	}
+	a.logger.Info("Migrated existing API key into the default profile")
+}
+
+// ValidateAPIKey checks an API key with a throwaway client and returns the
+// name of the user it belongs to. The active client and keyring are untouched.
+func (a *App) ValidateAPIKey(apiKey string) (string, error) {
+	apiKey = strings.TrimSpace(apiKey)
+	if apiKey == "" {
+		return "", fmt.Errorf("API key cannot be empty")
+	}
+
+	client, err := store.NewClient(apiKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to create PagerDuty client: %w", err)
+	}
+	// Stop the temporary client's queue goroutine whatever the outcome
+	defer client.Shutdown()
+
+	user, err := client.GetCurrentUser()
+	if err != nil {
+		return "", fmt.Errorf("invalid API key: %w", err)
+	}
+
+	a.logger.Info(fmt.Sprintf("API key validated for user %s", user.Name))
+	return user.Name, nil
+}
+
+// AddProfile validates an API key and stores it under a named profile
+func (a *App) AddProfile(name, apiKey string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("profile name is required")
+	}
+	if apiKey == "" {
+		return fmt.Errorf("API key cannot be empty")
+	}
+
+	if a.kr == nil {
+		return fmt.Errorf("keyring not available")
+	}
+
+	// Validate the key before saving it
+	if _, err := a.ValidateAPIKey(apiKey); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to validate API key for profile %s: %v", name, err))
+		return err
+	}
+
+	if err := a.kr.Set(keyring.Item{
+		Key:  apiKeyItemKey(name),
+		Data: []byte(apiKey),
+	}); err != nil {
+		return fmt.Errorf("failed to save API key: %w", err)
+	}
+
+	if err := a.addProfileName(name); err != nil {
+		return fmt.Errorf("failed to save profile: %w", err)
+	}
+
+	a.logger.Info(fmt.Sprintf("Added profile: %s", name))
+	return nil
+}
+
+// SwitchProfile makes another profile active: the client is rebuilt with the
+// profile's API key, cached user and incidents are cleared, and polling restarts.
+func (a *App) SwitchProfile(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("profile name is required")
+	}
+
+	found := false
+	for _, p := range a.ListProfiles() {
+		if p == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("profile %q not found", name)
+	}
+
+	if a.kr == nil {
+		return fmt.Errorf("keyring not available")
+	}
+
+	item, err := a.kr.Get(apiKeyItemKey(name))
+	if err != nil {
+		return fmt.Errorf("failed to load API key for profile %s: %w", name, err)
+	}
+
+	previous := a.activeProfile()
+	if err := a.db.SetState("active_profile", name); err != nil {
+		return fmt.Errorf("failed to save active profile: %w", err)
+	}
+
+	// Stop polling the old account before swapping clients
+	a.StopPolling()
+	a.StopUserPolling()
+	a.StopResolvedPolling()
+
+	a.resetAccountState()
+
+	// ConfigureAPIKey rebuilds the client and restarts polling
+	if err := a.ConfigureAPIKey(string(item.Data)); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to switch to profile %s: %v", name, err))
+		if err := a.db.SetState("active_profile", previous); err != nil {
+			a.logger.Warn(fmt.Sprintf("Failed to restore active profile: %v", err))
+		}
+		if a.getClient() != nil {
+			a.StartPolling()
+			a.StartUserPolling()
+			a.StartResolvedPolling()
+		}
+		return fmt.Errorf("failed to switch profile: %w", err)
+	}
+
+	a.logger.Info(fmt.Sprintf("Switched to profile: %s", name))
+	runtime.EventsEmit(a.ctx, "incidents-updated", "both")
+	return nil
+}
+
+// ResyncAll discards all cached incidents and fetch checkpoints and reloads
+// open and resolved incidents from PagerDuty. Use it when the local cache is
+// out of sync, e.g. incidents stuck open. Notification history is kept so
+// already-notified incidents don't alert again.
+func (a *App) ResyncAll() error {
+	if a.getClient() == nil {
+		return fmt.Errorf("PagerDuty client not initialized")
+	}
+
+	if a.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	if a.isPaused() {
+		return fmt.Errorf("polling is paused")
+	}
+
+	if !a.resyncMu.TryLock() {
+		return fmt.Errorf("resync already in progress")
+	}
+	defer a.resyncMu.Unlock()
+
+	a.logger.Info("Full resync requested")
+
+	if a.userCache != nil {
+		a.userCache.Invalidate()
+	}
+	if err := a.db.ClearUnwatchedIncidents(); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to clear incidents for resync: %v", err))
+		return fmt.Errorf("failed to clear incidents: %w", err)
+	}
+
+	a.previousOpenMu.Lock()
+	a.previousOpenIncidents = make(map[string]database.IncidentData)
+	a.previousOpenSeeded = false
+	a.alertCountBaseline = make(map[string]int)
+	a.pendingResolves = make(map[string]pendingResolve)
+	a.previousOpenMu.Unlock()
+
+	a.latestResolvedMu.Lock()
+	a.latestResolvedDate = time.Now().Add(-a.resolvedLookbackWindow())
+	a.latestResolvedMu.Unlock()
+
+	a.lastResolvedFetchMu.Lock()
+	a.lastResolvedFetch = time.Time{}
+	a.lastResolvedFetchMu.Unlock()
+
+	for _, key := range []string{"latest_resolved_date", "last_resolved_fetch"} {
+		if err := a.db.SetState(key, ""); err != nil {
+			a.logger.Warn(fmt.Sprintf("Failed to reset %s: %v", key, err))
+		}
+	}
+
+	// Fetches service (and user, when filtering) incidents synchronously
+	a.fetchAndUpdateIncidents()
+	a.performInitialResolvedFetch()
+
+	a.logger.Info("Full resync complete")
+	runtime.EventsEmit(a.ctx, "incidents-updated", "both")
+	return nil
+}
+
+// resetAccountState drops the cached user and incidents so data from one
+// account or client doesn't leak into another
+func (a *App) resetAccountState() {
+	if a.userCache != nil {
+		a.userCache.Invalidate()
+	}
+	if err := a.db.ClearIncidents(); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to clear incidents: %v", err))
+	}
+	if err := a.db.ClearPendingActions(); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to clear pending actions: %v", err))
+	}
+	a.previousOpenMu.Lock()
+	a.previousOpenIncidents = make(map[string]database.IncidentData)
+	a.previousOpenSeeded = false
+	a.alertCountBaseline = make(map[string]int)
+	a.pendingResolves = make(map[string]pendingResolve)
+	a.previousOpenMu.Unlock()
+	a.lastIncidentsMu.Lock()
+	a.lastIncidents = make(map[string]string)
+	a.lastIncidentsMu.Unlock()
+}
+
+// isDemoMode reports whether demo mode is enabled in saved settings
+func (a *App) isDemoMode() bool {
+	if a.db == nil {
+		return false
+	}
+	value, err := a.db.GetState("demo_mode")
+	return err == nil && value == "true"
+}
+
+// GetDemoMode reports whether the app is running on synthetic demo data
+func (a *App) GetDemoMode() bool {
+	return a.isDemoMode()
+}
+
+// getClient returns the current PagerDuty client, or nil when none is
+// configured. Callers should read it once and keep the local copy, since a
+// profile switch or API key change can swap it at any time.
+func (a *App) getClient() store.PagerDutyClient {
+	a.clientMu.Lock()
+	defer a.clientMu.Unlock()
+	return a.client
+}
+
+// setClient replaces the PagerDuty client and shuts down the previous one so
+// its queue worker doesn't leak. The old queue drains in the background.
+func (a *App) setClient(client store.PagerDutyClient) {
+	a.clientMu.Lock()
+	old := a.client
+	a.client = client
+	a.clientMu.Unlock()
+
+	// startDemoClient sets this again after swapping in the mock client
+	atomic.StoreInt32(&a.demoActive, 0)
+
+	if old != nil && old != client {
+		a.logger.Info("Shutting down previous PagerDuty client")
+		go old.Shutdown()
+	}
+
+	a.updateConnectionStatus()
+}
+
+// startDemoClient wires the mock client and starts polling it. Every service
+// is monitored while it is active, without touching the saved selection.
+func (a *App) startDemoClient() {
+	client := store.NewMockClient()
+	client.SetLogger(func(msg string) {
+		a.logger.Info(msg)
+	})
+	a.setClient(client)
+	atomic.StoreInt32(&a.demoActive, 1)
+
+	if user, err := client.GetCurrentUser(); err == nil {
+		a.userCache.Set(user.ID, user)
+	}
+
+	a.StartPolling()
+	a.StartUserPolling()
+	a.StartResolvedPolling()
+	go a.performInitialResolvedFetch()
+
+	a.logger.Info("Demo mode active: using synthetic PagerDuty data")
+}
+
+// EnableDemoMode switches between synthetic demo data and the real PagerDuty
+// account. The choice is persisted and applied again on the next launch.
+func (a *App) EnableDemoMode(enabled bool) error {
+	if a.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	value := "false"
+	if enabled {
+		value = "true"
+	}
+	if err := a.db.SetState("demo_mode", value); err != nil {
+		return fmt.Errorf("failed to save demo mode: %w", err)
+	}
+
+	a.StopPolling()
+	a.StopUserPolling()
+	a.StopResolvedPolling()
+	a.setClient(nil)
+	a.resetAccountState()
+
+	if enabled {
+		a.startDemoClient()
+	} else {
+		a.logger.Info("Demo mode disabled")
+		if apiKey, err := a.GetAPIKey(); err == nil && apiKey != "" {
+			if err := a.ConfigureAPIKey(apiKey); err != nil {
+				return fmt.Errorf("demo mode disabled but API key could not be restored: %w", err)
+			}
+		}
+	}
+
+	runtime.EventsEmit(a.ctx, "incidents-updated", "both")
+	return nil
+}
+
+func (a *App) UploadServicesConfig(
+	jsonData string) error {
+	var config store.ServicesConfig
+	if err := json.Unmarshal([]byte(jsonData), &config); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to parse services config: %v", err))
+		return fmt.Errorf("invalid JSON format: %w", err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.servicesConfig = &config
+
+	// Auto-select all services
+	a.selectedServices = serviceIDsFromConfig(&config)
+
+	a.saveServicesConfig(&config)
+	a.saveSelectedServices(a.selectedServices)
+
+	// Invalidate user cache on service change
+	if a.userCache != nil {
+		a.userCache.Invalidate()
+	}
+
+	// Trigger immediate refresh
+	go a.fetchAndUpdateIncidents()
+	go a.fetchResolvedIncidentsAdaptive()
+
+	// Emit event to update UI
+	runtime.EventsEmit(a.ctx, "services-config-updated")
+
+	return nil
+}
+
+// serviceIDsFromConfig flattens the service IDs in a config, expanding
+// grouped IDs and normalizing numeric ones
+func serviceIDsFromConfig(config *store.ServicesConfig) []string {
+	ids := []string{}
+	for _, service := range config.Services {
+		ids = append(ids, configuredServiceIDs(service.ID)...)
+	}
+	return ids
+}
+
+// configuredServiceIDs flattens a service config ID, which is a single
+// string, an array of strings, or a number when the config came from JSON
+func configuredServiceIDs(configID interface{}) []string {
+	switch id := configID.(type) {
+	case string:
+		return []string{id}
+	case []interface{}:
+		ids := make([]string, 0, len(id))
+		for _, sid := range id {
+			if strID, ok := sid.(string); ok {
+				ids = append(ids, strID)
+			}
+		}
+		return ids
+	case float64:
+		// Handle numeric IDs that come from JSON
+		return []string{fmt.Sprintf("%.0f", id)}
+	}
+	return []string{}
+}
+
+// saveServicesConfig persists the services config so it survives restarts.
+// A nil config clears the saved copy.
+func (a *App) saveServicesConfig(config *store.ServicesConfig) {
+	if a.db == nil {
+		return
+	}
+
+	value := ""
+	if config != nil {
+		data, err := json.Marshal(config)
+		if err != nil {
+			a.logger.Warn(fmt.Sprintf("Failed to encode services config: %v", err))
+			return
+		}
+		value = string(data)
+	}
+
+	if err := a.db.SetState("services_config", value); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to persist services config: %v", err))
+	}
+}
+
+// saveSelectedServices persists the service selection. A nil slice clears it.
+func (a *App) saveSelectedServices(services []string) {
+	if a.db == nil {
+		return
+	}
+
+	value := ""
+	if services != nil {
+		data, err := json.Marshal(services)
+		if err != nil {
+			a.logger.Warn(fmt.Sprintf("Failed to encode selected services: %v", err))
+			return
+		}
+		value = string(data)
+	}
+
+	if err := a.db.SetState("selected_services", value); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to persist selected services: %v", err))
+	}
+}
+
+// restoreServicesConfig reloads the saved services config and selection.
+// Saved selections are checked against the config and IDs that no longer
+// exist are dropped; with no saved selection every service is selected.
+func (a *App) restoreServicesConfig() {
+	value, err := a.db.GetState("services_config")
+	if err != nil || value == "" {
+		return
+	}
+
+	var config store.ServicesConfig
+	if err := json.Unmarshal([]byte(value), &config); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to parse saved services config: %v", err))
+		return
+	}
+
+	available := serviceIDsFromConfig(&config)
+	selected := available
+
+	if value, err := a.db.GetState("selected_services"); err == nil && value != "" {
+		var saved []string
+		if err := json.Unmarshal([]byte(value), &saved); err != nil {
+			a.logger.Warn(fmt.Sprintf("Failed to parse saved selected services: %v", err))
+		} else {
+			selected = []string{}
+			for _, id := range saved {
+				if containsService(available, id) {
+					selected = append(selected, id)
+				} else {
+					a.logger.Info(fmt.Sprintf("Dropping saved service %s: no longer in config", id))
+				}
+			}
+		}
+	}
+
+	a.mu.Lock()
+	a.servicesConfig = &config
+	a.selectedServices = selected
+	a.mu.Unlock()
+
+	a.logger.Info(fmt.Sprintf("Restored services config: %d of %d services selected", len(selected), len(available)))
+}
+
+func (a *App) RemoveServicesConfig() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.servicesConfig = nil
+	a.selectedServices = []string{}
+
+	a.saveServicesConfig(nil)
+	a.saveSelectedServices(nil)
+
+	a.logger.Info("Services configuration removed")
+
+	// Emit event to update UI
+	runtime.EventsEmit(a.ctx, "services-config-updated")
+
+	return nil
+}
+
+func (a *App) GetServicesConfig() (
+	*store.ServicesConfig, error,
+) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.servicesConfig == nil {
+		return nil, fmt.Errorf("no services configuration loaded")
+	}
+	return a.servicesConfig, nil
+}
+
+func (a *App) GetServiceNameByID(serviceID string) string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.servicesConfig == nil {
+		return ""
+	}
+
+	for _, service := range a.servicesConfig.Services {
+		for _, id := range configuredServiceIDs(service.ID) {
+			if id == serviceID {
+				return service.Name
+			}
+		}
+	}
+
+	return ""
+}
+
+func (a *App) SetSelectedServices(
+	services []string,
+) {
+	a.mu.Lock()
+	oldServices := a.selectedServices
+	a.selectedServices = services
+	a.mu.Unlock()
+
+	// Check if services actually changed
+	if !slicesEqual(oldServices, services) {
+		a.logger.Debug(fmt.Sprintf("Selected services updated: %d services", len(services)))
+
+		if services == nil {
+			services = []string{}
+		}
+		a.saveSelectedServices(services)
+
+		// Invalidate user cache on service change
+		if a.userCache != nil {
+			a.userCache.Invalidate()
+		}
+
+		// Trigger immediate refresh for both open and resolved
+		go a.fetchAndUpdateIncidents()
+		go a.fetchResolvedIncidentsAdaptive()
+	}
+}
+
+// ListTeams returns the account's teams for the team filter picker
+func (a *App) ListTeams() ([]store.Team, error) {
+	client := a.getClient()
+	if client == nil {
+		return nil, fmt.Errorf("PagerDuty client not initialized")
+	}
+
+	teams, err := client.ListTeams()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to list teams: %v", err))
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	return teams, nil
+}
+
+// SetTeamFilter limits fetched incidents to services owned by the given
+// teams, and persists the filter. An empty list removes the filter. The cache
+// is resynced so incidents from other teams don't linger.
+func (a *App) SetTeamFilter(teamIDs []string) error {
+	if teamIDs == nil {
+		teamIDs = []string{}
+	}
+
+	a.mu.Lock()
+	changed := !slicesEqual(a.teamFilter, teamIDs)
+	a.teamFilter = append([]string{}, teamIDs...)
+	a.mu.Unlock()
+
+	if a.db != nil {
+		data, err := json.Marshal(teamIDs)
+		if err != nil {
+			return fmt.Errorf("failed to encode team filter: %w", err)
+		}
+		if err := a.db.SetState("team_filter", string(data)); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist team filter: %v", err))
+			return fmt.Errorf("failed to save team filter: %w", err)
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("Team filter set to %d team(s)", len(teamIDs)))
+
+	if changed && a.getClient() != nil && !a.isPaused() {
+		go func() {
+			if err := a.ResyncAll(); err != nil {
+				a.logger.Warn(fmt.Sprintf("Resync after team filter change failed: %v", err))
+			}
+		}()
+	}
+	return nil
+}
+
+// GetTeamFilter returns the team IDs incidents are limited to
+func (a *App) GetTeamFilter() []string {
+	return a.teamFilterIDs()
+}
+
+func (a *App) teamFilterIDs() []string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return append([]string{}, a.teamFilter...)
+}
+
+// restoreTeamFilter reloads the saved team filter
+func (a *App) restoreTeamFilter() {
+	value, err := a.db.GetState("team_filter")
+	if err != nil || value == "" {
+		return
+	}
+
+	var teamIDs []string
+	if err := json.Unmarshal([]byte(value), &teamIDs); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to parse saved team filter: %v", err))
+		return
+	}
+
+	a.mu.Lock()
+	a.teamFilter = teamIDs
+	a.mu.Unlock()
+}
+
+func (a *App) GetSelectedServices() []string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return append([]string{}, a.selectedServices...)
+}
+
+func (a *App) ReadFile(
+	path string) (
+	string, error,
+) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to read file %s: %v", path, err))
+		return "", err
+	}
+	return string(content), nil
+}
+
+func (a *App) GetRateLimitStatus() map[string]interface{} {
+	currentRate := a.rateLimitTracker.GetCurrentRate()
+	status := map[string]interface{}{
+		"current":        currentRate,
+		"max":            pagerDutyRateLimit,
+		"remaining":      pagerDutyRateLimit - currentRate,
+		"percentage":     float64(currentRate) / float64(pagerDutyRateLimit) * 100,
+		"configured_max": a.rateLimitTracker.MaxCalls(),
+	}
+
+	client := a.getClient()
+	if client != nil {
+		status["queue_max"] = client.MaxCallsPerMinute()
+		status["rate_limited"] = client.IsRateLimited()
+	}
+
+	if a.circuitBreaker != nil {
+		status["circuit_breaker"] = map[string]interface{}{
+			"state":      atomic.LoadInt32(&a.circuitBreaker.state),
+			"state_name": circuitStateName(atomic.LoadInt32(&a.circuitBreaker.state)),
+			"failures":   atomic.LoadInt32(&a.circuitBreaker.failures),
+			"config":     a.circuitBreaker.Config(),
+		}
+	}
+
+	return status
+}
+
+// GetAPIQueueStats reports the API queue's total, failed and pending request
+// counts and the failure rate, to tell a backed-up queue from a failing API
+func (a *App) GetAPIQueueStats() map[string]interface{} {
+	stats := map[string]interface{}{
+		"total_calls":      int64(0),
+		"failed_calls":     int64(0),
+		"pending_requests": 0,
+		"failure_rate":     0.0,
+		"rate_limited":     false,
+	}
+
+	client := a.getClient()
+	if client == nil {
+		return stats
+	}
+
+	total, failed, pending, rateLimited := client.GetAPIStats()
+	stats["total_calls"] = total
+	stats["failed_calls"] = failed
+	stats["pending_requests"] = pending
+	if total > 0 {
+		stats["failure_rate"] = float64(failed) / float64(total) * 100
+	}
+	stats["rate_limited"] = rateLimited
+
+	return stats
+}
+
+// GetDatabaseInfo returns the database file size, row counts per table and
+// the oldest and newest incident times, for the diagnostics screen
+func (a *App) GetDatabaseInfo() (map[string]interface{}, error) {
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	info := map[string]interface{}{
+		"path": a.db.Path(),
+	}
+
+	stat, err := os.Stat(a.db.Path())
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat database file: %w", err)
+	}
+	info["file_size_bytes"] = stat.Size()
+
+	// In WAL mode recent writes live in the -wal file until checkpointed
+	if walStat, err := os.Stat(a.db.Path() + "-wal"); err == nil {
+		info["wal_size_bytes"] = walStat.Size()
+	} else {
+		info["wal_size_bytes"] = int64(0)
+	}
+
+	counts, err := a.db.GetTableRowCounts()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to count database rows: %v", err))
+		return nil, err
+	}
+	info["row_counts"] = counts
+
+	oldest, newest, err := a.db.GetIncidentTimeRange()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to get incident time range: %v", err))
+		return nil, err
+	}
+	info["oldest_incident"] = nil
+	info["newest_incident"] = nil
+	if oldest != nil {
+		info["oldest_incident"] = oldest.Format(time.RFC3339)
+	}
+	if newest != nil {
+		info["newest_incident"] = newest.Format(time.RFC3339)
+	}
+
+	if version, err := a.db.SchemaVersion(); err == nil {
+		info["schema_version"] = version
+	}
+
+	return info, nil
+}
+
+// GetHealthStatus gathers the client, storage, polling and notification state
+// into one diagnostic report for troubleshooting stalled updates
+func (a *App) GetHealthStatus() map[string]interface{} {
+	status := map[string]interface{}{
+		"client_initialized": a.getClient() != nil,
+		"keyring_available":  a.kr != nil,
+		"keyring_backend":    a.GetKeyringBackend(),
+		"keyring_insecure":   a.GetKeyringBackend() == keyringBackendFileInsecure,
+		"demo_mode":          a.isDemoMode(),
+		"paused":             a.isPaused(),
+		"connection":         a.GetConnectionStatus(),
+	}
+
+	dbStatus := map[string]interface{}{"open": false}
+	if a.db != nil {
+		if err := a.db.Ping(); err != nil {
+			dbStatus["error"] = err.Error()
+		} else {
+			dbStatus["open"] = true
+		}
+		dbStatus["path"] = a.db.Path()
+	}
+	status["database"] = dbStatus
+
+	a.lastSuccessfulFetchMu.RLock()
+	lastFetch := a.lastSuccessfulFetch
+	a.lastSuccessfulFetchMu.RUnlock()
+	if lastFetch.IsZero() {
+		status["last_successful_fetch"] = nil
+	} else {
+		status["last_successful_fetch"] = lastFetch.Format(time.RFC3339)
+		status["seconds_since_fetch"] = int(time.Since(lastFetch).Seconds())
+	}
+
+	if a.circuitBreaker != nil {
+		state := atomic.LoadInt32(&a.circuitBreaker.state)
+		status["circuit_breaker"] = map[string]interface{}{
+			"state":      state,
+			"state_name": circuitStateName(state),
+			"failures":   atomic.LoadInt32(&a.circuitBreaker.failures),
+		}
+	}
+
+	if a.rateLimitTracker != nil {
+		status["rate_limit"] = map[string]interface{}{
+			"current":        a.rateLimitTracker.GetCurrentRate(),
+			"configured_max": a.rateLimitTracker.MaxCalls(),
+		}
+	}
+
+	a.pollMu.RLock()
+	servicePolling := a.polling
+	a.pollMu.RUnlock()
+	a.resolvedPollMu.RLock()
+	resolvedPolling := a.resolvedPolling
+	a.resolvedPollMu.RUnlock()
+	a.userPollMu.RLock()
+	userPolling := a.userPolling
+	a.userPollMu.RUnlock()
+	status["polling"] = map[string]interface{}{
+		"services": servicePolling,
+		"resolved": resolvedPolling,
+		"user":     userPolling,
+		"webhooks": a.IsWebhookServerRunning(),
+	}
+
+	if a.notificationMgr != nil {
+		status["notifications"] = map[string]interface{}{
+			"supported": a.notificationMgr.IsSupported(),
+			"binaries":  a.notificationMgr.BinaryAvailability(),
+		}
+	}
+
+	return status
+}
+
+// SetRateLimitConfig sets the maximum API calls per minute used by both the
+// polling rate limit tracker and the client's API queue, and persists it.
+func (a *App) SetRateLimitConfig(maxCallsPerMinute int) error {
+	if maxCallsPerMinute < minRateLimit || maxCallsPerMinute > pagerDutyRateLimit {
+		return fmt.Errorf("rate limit must be between %d and %d calls per minute", minRateLimit, pagerDutyRateLimit)
+	}
+
+	if a.rateLimitTracker != nil {
+		a.rateLimitTracker.SetMaxCalls(maxCallsPerMinute)
+	}
+	client := a.getClient()
+	if client != nil {
+		client.SetMaxCallsPerMinute(maxCallsPerMinute)
+	}
+
+	if a.db != nil {
+		if err := a.db.SetStateInt("rate_limit_max_calls", maxCallsPerMinute); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist rate limit setting: %v", err))
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("Rate limit set to %d calls per minute", maxCallsPerMinute))
+	return nil
+}
+
+// SetAPITimeouts sets how long list fetches and resolved incident fetches may
+// take, in seconds, and persists them. Raise the resolved timeout on slow
+// networks with large resolved datasets.
+func (a *App) SetAPITimeouts(standardSec, resolvedSec int) error {
+	for _, sec := range []int{standardSec, resolvedSec} {
+		if sec < minAPITimeoutSeconds || sec > maxAPITimeoutSeconds {
+			return fmt.Errorf("API timeouts must be between %d and %d seconds", minAPITimeoutSeconds, maxAPITimeoutSeconds)
+		}
+	}
+
+	client := a.getClient()
+	if client != nil {
+		client.SetTimeouts(time.Duration(standardSec)*time.Second, time.Duration(resolvedSec)*time.Second)
+	}
+
+	if a.db != nil {
+		if err := a.db.SetStateInt("api_timeout_standard", standardSec); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist standard API timeout: %v", err))
+		}
+		if err := a.db.SetStateInt("api_timeout_resolved", resolvedSec); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist resolved API timeout: %v", err))
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("API timeouts set to %ds standard, %ds resolved", standardSec, resolvedSec))
+	return nil
+}
+
+// SetMaxOpenResults sets how many open incidents each poll fetches per service
+// or user query, and persists it. Raise it for services with more open
+// incidents than the default cap of store.DefaultMaxResults.
+func (a *App) SetMaxOpenResults(n int) error {
+	if n < minMaxOpenResults || n > maxMaxOpenResults {
+		return fmt.Errorf("max open results must be between %d and %d", minMaxOpenResults, maxMaxOpenResults)
+	}
+
+	client := a.getClient()
+	if client != nil {
+		client.SetMaxOpenResults(n)
+	}
+
+	if a.db != nil {
+		if err := a.db.SetStateInt("max_open_results", n); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist max open results: %v", err))
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("Max open incidents per fetch set to %d", n))
+	return nil
+}
+
+// applySavedMaxOpenResults applies any persisted open incident cap to a new client
+func (a *App) applySavedMaxOpenResults(client store.PagerDutyClient) {
+	if a.db == nil {
+		return
+	}
+
+	if n, err := a.db.GetStateInt("max_open_results"); err == nil && n >= minMaxOpenResults && n <= maxMaxOpenResults {
+		client.SetMaxOpenResults(n)
+	}
+}
+
+// SetCircuitBreakerConfig sets how many consecutive failures open the circuit
+// breaker and the bounds of its exponential backoff
+func (a *App) SetCircuitBreakerConfig(maxFailures int, cooldownSeconds int, maxBackoffSeconds int) error {
+	cfg := CircuitBreakerConfig{
+		MaxFailures:       maxFailures,
+		CooldownSeconds:   cooldownSeconds,
+		MaxBackoffSeconds: maxBackoffSeconds,
+	}
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+
+	if a.circuitBreaker != nil {
+		a.circuitBreaker.Configure(maxFailures, time.Duration(cooldownSeconds)*time.Second, time.Duration(maxBackoffSeconds)*time.Second)
+	}
+
+	if a.db != nil {
+		data, err := json.Marshal(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to encode circuit breaker config: %w", err)
+		}
+		if err := a.db.SetState("circuit_breaker_config", string(data)); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist circuit breaker config: %v", err))
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("Circuit breaker set to maxFailures=%d cooldown=%ds maxBackoff=%ds",
+		maxFailures, cooldownSeconds, maxBackoffSeconds))
+	return nil
+}
+
+// validate checks that all values are positive and the cooldown fits under the cap
+func (cfg CircuitBreakerConfig) validate() error {
+	if cfg.MaxFailures <= 0 || cfg.CooldownSeconds <= 0 || cfg.MaxBackoffSeconds <= 0 {
+		return fmt.Errorf("circuit breaker settings must be positive")
+	}
+	if cfg.CooldownSeconds > cfg.MaxBackoffSeconds {
+		return fmt.Errorf("cooldown must not exceed the maximum backoff")
+	}
+	return nil
+}
+
+// initCircuitBreaker creates the circuit breaker with any persisted settings
+func (a *App) initCircuitBreaker() {
+	a.circuitBreaker = NewCircuitBreaker()
+	a.circuitBreaker.SetStateChangeHandler(a.emitCircuitBreakerState)
+
+	if a.db == nil {
+		return
+	}
+
+	value, err := a.db.GetState("circuit_breaker_config")
+	if err != nil || value == "" {
+		return
+	}
+
+	var cfg CircuitBreakerConfig
+	if err := json.Unmarshal([]byte(value), &cfg); err != nil || cfg.validate() != nil {
+		a.logger.Warn(fmt.Sprintf("Ignoring invalid circuit_breaker_config: %s", value))
+		return
+	}
+	a.circuitBreaker.Configure(cfg.MaxFailures, time.Duration(cfg.CooldownSeconds)*time.Second, time.Duration(cfg.MaxBackoffSeconds)*time.Second)
+}
+
+// applySavedAPITimeouts applies any persisted API timeouts to a new client.
+// Missing or invalid values leave the client's defaults in place.
+func (a *App) applySavedAPITimeouts(client store.PagerDutyClient) {
+	if a.db == nil {
+		return
+	}
+
+	savedTimeout := func(key string) time.Duration {
+		sec, err := a.db.GetStateInt(key)
+		if err != nil || sec < minAPITimeoutSeconds || sec > maxAPITimeoutSeconds {
+			return 0
+		}
+		return time.Duration(sec) * time.Second
+	}
+
+	standard := savedTimeout("api_timeout_standard")
+	resolved := savedTimeout("api_timeout_resolved")
+	if standard > 0 || resolved > 0 {
+		client.SetTimeouts(standard, resolved)
+	}
+}
+
+// savedRateLimit returns the persisted rate limit, or 0 if none is saved
+func (a *App) savedRateLimit() int {
+	if a.db == nil {
+		return 0
+	}
+
+	maxCalls, err := a.db.GetStateInt("rate_limit_max_calls")
+	if err != nil || maxCalls < minRateLimit || maxCalls > pagerDutyRateLimit {
+		return 0
+	}
+	return maxCalls
+}
+
+func (a *App) GetNotificationConfig() NotificationConfig {
+	if a.notificationMgr == nil {
+		return NotificationConfig{}
+	}
+	return a.notificationMgr.GetConfig()
+}
+
+// IsNotificationSupported reports whether visual notifications can be shown on this platform
+func (a *App) IsNotificationSupported() bool {
+	if a.notificationMgr != nil {
+		return a.notificationMgr.IsSupported()
+	}
+	return false
+}
+
+func (a *App) SetNotificationEnabled(enabled bool) {
+	if a.notificationMgr != nil {
+		a.notificationMgr.SetEnabled(enabled)
+		a.saveNotificationConfig()
+	}
+}
+
+func (a *App) SetNotificationSound(sound string) {
+	if a.notificationMgr != nil {
+		a.notificationMgr.SetSound(sound)
+		a.saveNotificationConfig()
+	}
+}
+
+// SetNotificationMinUrgency sets the lowest urgency ("low" or "high") that
+// triggers notifications
+func (a *App) SetNotificationMinUrgency(urgency string) error {
+	if a.notificationMgr == nil {
+		return fmt.Errorf("notification manager not initialized")
+	}
+
+	if err := a.notificationMgr.SetMinUrgency(urgency); err != nil {
+		return err
+	}
+	a.saveNotificationConfig()
+	return nil
+}
+
+// SetServiceNotificationSound sets a per-service notification sound. An empty
+// sound clears the override so the global sound is used.
+func (a *App) SetServiceNotificationSound(serviceID, sound string) error {
+	if serviceID == "" {
+		return fmt.Errorf("service ID is required")
+	}
+
+	if a.notificationMgr == nil {
+		return fmt.Errorf("notification manager not initialized")
+	}
+
+	a.notificationMgr.SetServiceSound(serviceID, sound)
+
+	// Persist the full override map
+	if a.db != nil {
+		data, err := json.Marshal(a.notificationMgr.GetServiceSounds())
+		if err != nil {
+			return fmt.Errorf("failed to encode service sounds: %w", err)
+		}
+		if err := a.db.SetState("service_sounds", string(data)); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist service sounds: %v", err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetServiceNotificationSounds returns the per-service sound overrides keyed by service ID
+func (a *App) GetServiceNotificationSounds() map[string]string {
+	if a.notificationMgr != nil {
+		return a.notificationMgr.GetServiceSounds()
+	}
+	return map[string]string{}
+}
+
+func (a *App) TestNotificationSound() error {
+	if a.notificationMgr != nil {
+		return a.notificationMgr.TestSound()
+	}
+	return fmt.Errorf("notification manager not initialized")
+}
+
+// TestNotification shows a sample visual notification so users can check
+// the platform notifier works, independent of sound
+func (a *App) TestNotification() error {
+	if a.notificationMgr != nil {
+		return a.notificationMgr.TestNotification()
+	}
+	return fmt.Errorf("notification manager not initialized")
+}
+
+func (a *App) GetAvailableSounds() []string {
+	if a.notificationMgr != nil {
+		sounds, err := a.notificationMgr.GetAvailableSounds()
+		if err != nil {
+			a.logger.Warn(fmt.Sprintf("Failed to get available sounds: %v", err))
+			return []string{"default"}
+		}
+		return sounds
+	}
+	return []string{"default"}
+}
+
+func (a *App) SnoozeNotificationSound(minutes int) {
+	if a.notificationMgr != nil {
+		a.notificationMgr.SnoozeSound(minutes)
+		a.saveNotificationConfig()
+		runtime.EventsEmit(a.ctx, "notification-snoozed", minutes)
+	}
+}
+
+// SetPaused pauses or resumes all polling and notifications. Unlike a snooze,
+// which only mutes sound, no API calls are made while paused. The setting is
+// persisted so it survives a restart.
+func (a *App) SetPaused(paused bool) error {
+	if paused {
+		atomic.StoreInt32(&a.paused, 1)
+		a.StopPolling()
+		a.StopUserPolling()
+		a.StopResolvedPolling()
+		a.logger.Info("Polling and notifications paused")
+	} else {
+		atomic.StoreInt32(&a.paused, 0)
+		if a.getClient() != nil {
+			a.StartPolling()
+			a.StartUserPolling()
+			a.StartResolvedPolling()
+		}
+		a.logger.Info("Polling and notifications resumed")
+	}
+
+	if a.db != nil {
+		if err := a.db.SetStateBool("paused", paused); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist paused state: %v", err))
+			return fmt.Errorf("failed to save paused state: %w", err)
+		}
+	}
+
+	runtime.EventsEmit(a.ctx, "paused-changed", paused)
+	return nil
+}
+
+// SetMonitorAllServices turns "all services" mode on or off. When on, open and
+// resolved incidents are fetched for every service the API key can see
+// instead of only the selected ones; disabled services are still hidden.
+func (a *App) SetMonitorAllServices(enabled bool) error {
+	var value int32
+	if enabled {
+		value = 1
+	}
+	atomic.StoreInt32(&a.monitorAll, value)
+
+	if a.db != nil {
+		if err := a.db.SetStateBool("monitor_all_services", enabled); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to persist monitor all services: %v", err))
+			return fmt.Errorf("failed to save monitor all services: %w", err)
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("Monitor all services: %v", enabled))
+
+	// Refresh right away instead of waiting for the next poll
+	if a.getClient() != nil && !a.isPaused() {
+		go func() {
+			a.fetchAndUpdateIncidents()
+			a.fetchResolvedIncidentsSince()
+		}()
+	}
+	runtime.EventsEmit(a.ctx, "incidents-updated", "both")
+	return nil
+}
+
+// IsMonitoringAllServices reports whether "all services" mode is on
+func (a *App) IsMonitoringAllServices() bool {
+	return a.monitoringAll()
+}
+
+// monitoringAll also covers demo mode, whose synthetic services are never in
+// the user's saved selection
+func (a *App) monitoringAll() bool {
+	return atomic.LoadInt32(&a.monitorAll) == 1 || atomic.LoadInt32(&a.demoActive) == 1
+}
+
+// IsPaused reports whether polling and notifications are paused
+func (a *App) IsPaused() bool {
+	return a.isPaused()
+}
+
+func (a *App) isPaused() bool {
+	return atomic.LoadInt32(&a.paused) == 1
+}
+
+func (a *App) UnsnoozeNotificationSound() {
+	if a.notificationMgr != nil {
+		a.notificationMgr.UnsnoozeSound()
+		a.saveNotificationConfig()
+		runtime.EventsEmit(a.ctx, "notification-unsnoozed")
+	}
+}
+
+// SetNotificationTemplates sets the title and message templates for incident
+// notifications, using {service}, {title}, {urgency} and {number} placeholders.
+// Empty templates restore the default service summary and incident title.
+func (a *App) SetNotificationTemplates(titleTemplate, messageTemplate string) error {
+	if a.notificationMgr == nil {
+		return fmt.Errorf("notification manager not initialized")
+	}
+
+	a.notificationMgr.SetTemplates(strings.TrimSpace(titleTemplate), strings.TrimSpace(messageTemplate))
+	a.saveNotificationConfig()
+	return nil
+}
+
+// SetQuietHours configures a recurring daily window ("HH:MM" local time, may
+// span midnight) during which notification sounds are muted
+func (a *App) SetQuietHours(start, end string, enabled bool) error {
+	if a.notificationMgr == nil {
+		return fmt.Errorf("notification manager not initialized")
+	}
+
+	if err := a.notificationMgr.SetQuietHours(start, end, enabled); err != nil {
+		return err
+	}
+	a.saveNotificationConfig()
+	return nil
+}
+
+func (a *App) IsNotificationSnoozed() bool {
+	if a.notificationMgr != nil {
+		return a.notificationMgr.IsSnoozeActive()
+	}
+	return false
+}
+
+func (a *App) shutdown(ctx context.Context) {
+	a.logger.Info("Shutting down PagerOps...")
+
+	// First, stop all polling to prevent new operations
+	a.StopPolling()
+	a.StopUserPolling()
+	a.StopResolvedPolling()
+
+	// Stop receiving webhooks
+	if err := a.StopWebhookServer(); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to stop webhook server: %v", err))
+	}
+
+	// Then signal shutdown to running goroutines
+	close(a.shutdownChan)
+
+	// Shutdown notification manager
+	if a.notificationMgr != nil {
+		a.notificationMgr.Shutdown()
+	}
+
+	// Wait for goroutines with timeout
+	done := make(chan struct{})
+	go func() {
+		a.shutdownWg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		a.logger.Info("All goroutines stopped")
+	case <-time.After(10 * time.Second):
+		a.logger.Warn("Shutdown timeout - some goroutines may not have stopped")
+	}
+
+	// Shutdown the client API queue
+	client := a.getClient()
+	if client != nil {
+		client.Shutdown()
+	}
+
+	// Close database
+	if a.db != nil {
+		if err := a.db.Close(); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to close database: %v", err))
+		}
+	}
+
+	// Close logger
+	if a.logger != nil {
+		a.logger.Close()
+	}
+}
+
+// Helper function to compare slices
+func slicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if v != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// NoteInput represents the structured note data from the frontend
+type NoteInput struct {
+	Responses       []store.NoteResponse `json:"responses"`
+	Tags            []store.NoteTag      `json:"tags"`
+	FreeformContent string               `json:"freeform_content"`
+	// AllowDuplicate posts the note even if identical content was just added,
+	// after the user confirmed an ErrDuplicateNote prompt
+	AllowDuplicate bool `json:"allow_duplicate"`
+}
+
+// ErrDuplicateNote is returned by AddIncidentNote when the same note was added
+// to the incident within duplicateNoteWindow, usually an accidental double-submit
+var ErrDuplicateNote = errors.New("duplicate note: identical content was added recently")
+
+// duplicateNoteWindow is how recent an identical note must be to count as a duplicate
+const duplicateNoteWindow = 5 * time.Minute
+
+// isDuplicateNote reports whether the incident already has a note with the
+// same content created within duplicateNoteWindow. Cached notes are checked
+// first; the API is only called when nothing is cached. Posting a note clears
+// the cache, so a note just added is always checked against the API.
+func (a *App) isDuplicateNote(client store.PagerDutyClient, incidentID, content string) bool {
+	var notes []store.IncidentNote
+	if a.db != nil {
+		if cached, err := a.db.GetIncidentNotes(incidentID); err == nil {
+			notes = convertDBToStoreNotes(cached)
+		}
+	}
+
+	if len(notes) == 0 {
+		fetched, err := client.GetIncidentNotes(incidentID)
+		if err != nil {
+			// Don't block posting just because the check couldn't run
+			a.logger.Warn(fmt.Sprintf("Failed to check for duplicate notes on %s: %v", incidentID, err))
+			return false
+		}
+		notes = fetched
+	}
+
+	content = strings.TrimSpace(content)
+	for _, note := range notes {
+		if strings.TrimSpace(note.Content) != content {
+			continue
+		}
+		createdAt, err := time.Parse(time.RFC3339, note.CreatedAt)
+		if err == nil && time.Since(createdAt) < duplicateNoteWindow {
+			return true
+		}
+	}
+	return false
+}
+
+// getUserEmail retrieves the current user's email from cache
+func (a *App) getUserEmail() (string, error) {
+	if a.userCache == nil {
+		return "", fmt.Errorf("user cache not initialized")
+	}
+
+	// Try to get from cache first
+	a.userCache.mu.RLock()
+	user := a.userCache.user
+	a.userCache.mu.RUnlock()
+
+	if user != nil {
+		if pdUser, ok := user.(*pagerduty.User); ok && pdUser.Email != "" {
+			return pdUser.Email, nil
 		}
 	}
 
-	// Set error if any occurred
-	if len(errors) > 0 {
-		response.Error = strings.Join(errors, "; ")
+	// If not in cache or no email, fetch fresh user data
+	client := a.getClient()
+	if client == nil {
+		return "", fmt.Errorf("PagerDuty client not initialized")
 	}
 
-	return response, nil
+	freshUser, err := client.GetCurrentUser()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	// Update cache with fresh data
+	a.userCache.Set(freshUser.ID, freshUser)
+
+	if freshUser.Email == "" {
+		return "", fmt.Errorf("user email not available")
+	}
+
+	return freshUser.Email, nil
 }
 
-func convertDBToStoreAlerts(dbAlerts []database.SidebarAlert) []store.IncidentAlert {
-	alerts := make([]store.IncidentAlert, len(dbAlerts))
-	for i, dbAlert := range dbAlerts {
-		alert := store.IncidentAlert{
-			ID:          dbAlert.ID,
-			Summary:     dbAlert.Summary,
-			Status:      dbAlert.Status,
-			CreatedAt:   dbAlert.CreatedAt,
-			ServiceName: dbAlert.ServiceName,
-			Description: dbAlert.Description,
-			Links:       []store.AlertLink{},
-		}
+// AcknowledgeIncident acknowledges an incident via the PagerDuty API
+func (a *App) AcknowledgeIncident(incidentID string) error {
+	if incidentID == "" {
+		return fmt.Errorf("incident ID is required")
+	}
 
-		// Deserialize links from JSON
-		if dbAlert.Links != "" {
-			json.Unmarshal([]byte(dbAlert.Links), &alert.Links)
-		}
+	client := a.getClient()
+	if client == nil {
+		return fmt.Errorf("PagerDuty client not initialized")
+	}
 
-		alerts[i] = alert
+	// Get current user's email
+	userEmail, err := a.getUserEmail()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to get user email for acknowledge: %v", err))
+		return fmt.Errorf("failed to get user email: %w", err)
 	}
-	return alerts
-}
 
-// Helper function to convert store alerts to database alerts
-func convertStoreToDBalerts(storeAlerts []store.IncidentAlert) []database.SidebarAlert {
-	dbAlerts := make([]database.SidebarAlert, len(storeAlerts))
-	for i, storeAlert := range storeAlerts {
-		// Serialize links to JSON
-		linksJSON, _ := json.Marshal(storeAlert.Links)
+	a.logger.Info(fmt.Sprintf("Acknowledging incident %s as user %s", incidentID, userEmail))
 
-		dbAlerts[i] = database.SidebarAlert{
-			ID:          storeAlert.ID,
-			Summary:     storeAlert.Summary,
-			Status:      storeAlert.Status,
-			CreatedAt:   storeAlert.CreatedAt,
-			ServiceName: storeAlert.ServiceName,
-			Description: storeAlert.Description,
-			Links:       string(linksJSON),
+	// Call API to acknowledge incident
+	err = client.AcknowledgeIncident(incidentID, userEmail)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to acknowledge incident %s: %v", incidentID, err))
+		if a.queuePendingAction(pendingActionAcknowledge, incidentID, "", err) {
+			return fmt.Errorf("failed to acknowledge incident, queued for retry: %w", err)
 		}
+		return fmt.Errorf("failed to acknowledge incident: %w", err)
 	}
-	return dbAlerts
+
+	a.logger.Info(fmt.Sprintf("Successfully acknowledged incident %s", incidentID))
+
+	// Trigger immediate fetch to update UI quickly
+	// The polling will also pick this up, but this provides instant feedback
+	go a.fetchAndUpdateIncidents()
+
+	return nil
 }
 
-// Helper function to convert database notes to store notes
-func convertDBToStoreNotes(dbNotes []database.SidebarNote) []store.IncidentNote {
-	notes := make([]store.IncidentNote, len(dbNotes))
-	for i, dbNote := range dbNotes {
-		note := store.IncidentNote{
-			ID:              dbNote.ID,
-			Content:         dbNote.Content,
-			CreatedAt:       dbNote.CreatedAt,
-			UserName:        dbNote.UserName,
-			ServiceID:       dbNote.ServiceID,
-			FreeformContent: dbNote.FreeformContent,
-		}
+// AcknowledgeIncidentOptimistic acknowledges an incident like
+// AcknowledgeIncident, but marks the local row acknowledged first and emits
+// incident-acknowledged for just that incident, so the UI can update one row
+// instead of re-rendering the whole list. A transient API failure is queued
+// for retry and keeps the local status; any other failure reverts it.
+// Otherwise the background fetch confirms it.
+func (a *App) AcknowledgeIncidentOptimistic(incidentID string) error {
+	if incidentID == "" {
+		return fmt.Errorf("incident ID is required")
+	}
 
-		// Deserialize responses from JSON
-		if dbNote.Responses != "" {
-			var responses []store.NoteResponse
-			if err := json.Unmarshal([]byte(dbNote.Responses), &responses); err == nil {
-				note.Responses = responses
-			}
-		}
+	client := a.getClient()
+	if client == nil {
+		return fmt.Errorf("PagerDuty client not initialized")
+	}
 
-		// Deserialize tags from JSON
-		if dbNote.Tags != "" {
-			var tags []store.NoteTag
-			if err := json.Unmarshal([]byte(dbNote.Tags), &tags); err == nil {
-				note.Tags = tags
-			}
-		}
+	if a.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
 
-		notes[i] = note
+	userEmail, err := a.getUserEmail()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to get user email for acknowledge: %v", err))
+		return fmt.Errorf("failed to get user email: %w", err)
 	}
-	return notes
-}
 
-// Helper function to convert store notes to database notes
-func convertStoreToDbnotes(storeNotes []store.IncidentNote) []database.SidebarNote {
-	dbNotes := make([]database.SidebarNote, len(storeNotes))
-	for i, storeNote := range storeNotes {
-		dbNote := database.SidebarNote{
-			ID:              storeNote.ID,
-			Content:         storeNote.Content,
-			CreatedAt:       storeNote.CreatedAt,
-			UserName:        storeNote.UserName,
-			ServiceID:       storeNote.ServiceID,
-			FreeformContent: storeNote.FreeformContent,
-		}
+	incident, err := a.db.GetIncidentByID(incidentID)
+	if err != nil {
+		return err
+	}
 
-		// Serialize responses to JSON
-		if len(storeNote.Responses) > 0 {
-			if responsesJSON, err := json.Marshal(storeNote.Responses); err == nil {
-				dbNote.Responses = string(responsesJSON)
-			}
-		}
+	previousStatus, err := a.db.SetIncidentStatus(incidentID, "acknowledged")
+	if err != nil {
+		return err
+	}
 
-		// Serialize tags to JSON
-		if len(storeNote.Tags) > 0 {
-			if tagsJSON, err := json.Marshal(storeNote.Tags); err == nil {
-				dbNote.Tags = string(tagsJSON)
-			}
+	// Attribute the ack locally so the UI can show it before the API confirms
+	ackedBy := userEmail
+	if a.userCache != nil && a.userCache.UserName() != "" {
+		ackedBy = a.userCache.UserName()
+	}
+	acknowledgedAt := incident.AcknowledgedAt
+	if acknowledgedAt == nil {
+		now := time.Now()
+		acknowledgedAt = &now
+	}
+	if err := a.db.SetIncidentAckedBy(incidentID, ackedBy, acknowledgedAt); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to record local ack attribution for %s: %v", incidentID, err))
+	}
+
+	runtime.EventsEmit(a.ctx, "incident-acknowledged", IncidentStatusEvent{
+		IncidentID: incidentID,
+		Title:      incident.Title,
+		Status:     "acknowledged",
+	})
+
+	a.logger.Info(fmt.Sprintf("Acknowledging incident %s as user %s (optimistic)", incidentID, userEmail))
+
+	if err := client.AcknowledgeIncident(incidentID, userEmail); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to acknowledge incident %s: %v", incidentID, err))
+
+		// A transient failure is retried in the background, so the optimistic
+		// state stays. If the retries give up, the next poll restores the
+		// incident's real status.
+		if a.queuePendingAction(pendingActionAcknowledge, incidentID, "", err) {
+			return nil
 		}
 
-		dbNotes[i] = dbNote
+		if _, revertErr := a.db.SetIncidentStatus(incidentID, previousStatus); revertErr != nil {
+			a.logger.Error(fmt.Sprintf("Failed to revert status of incident %s: %v", incidentID, revertErr))
+		}
+		if revertErr := a.db.SetIncidentAckedBy(incidentID, incident.AckedBy, incident.AcknowledgedAt); revertErr != nil {
+			a.logger.Error(fmt.Sprintf("Failed to revert ack attribution of incident %s: %v", incidentID, revertErr))
+		}
+		runtime.EventsEmit(a.ctx, "incident-"+previousStatus, IncidentStatusEvent{
+			IncidentID: incidentID,
+			Title:      incident.Title,
+			Status:     previousStatus,
+		})
+		return fmt.Errorf("failed to acknowledge incident: %w", err)
 	}
-	return dbNotes
+
+	a.logger.Info(fmt.Sprintf("Successfully acknowledged incident %s", incidentID))
+
+	// Confirm in the background
+	go a.fetchAndUpdateIncidents()
+
+	return nil
 }
 
-func (a *App) GetServiceConfigByServiceID(serviceID string) (*store.ServiceConfig, error) {
-	a.mu.RLock()
-	defer a.mu.RUnlock()
+// Pending action types and retry policy for write operations that failed
+// with a transient error
+const (
+	pendingActionAcknowledge = "acknowledge"
+	pendingActionNote        = "note"
 
-	if a.servicesConfig == nil {
-		return nil, fmt.Errorf("no services configuration loaded")
+	pendingActionRetryInterval = 30 * time.Second
+	pendingActionMaxBackoff    = 30 * time.Minute
+	pendingActionMaxAttempts   = 10
+)
+
+// queuePendingAction stores a failed write for background retry when the
+// error looks transient. It reports whether the action was queued.
+func (a *App) queuePendingAction(actionType, incidentID, payload string, cause error) bool {
+	if a.db == nil || !isRetryableFetchError(cause) {
+		return false
 	}
 
-	for _, service := range a.servicesConfig.Services {
-		switch id := service.ID.(type) {
-		case string:
-			if id == serviceID {
-				return &service, nil
-			}
-		case []interface{}:
-			for _, sid := range id {
-				if strID, ok := sid.(string); ok && strID == serviceID {
-					return &service, nil
-				}
-			}
-		case float64:
-			if fmt.Sprintf("%.0f", id) == serviceID {
-				return &service, nil
-			}
-		}
+	next := time.Now().Add(pendingActionBackoff(1))
+	if err := a.db.AddPendingAction(actionType, incidentID, payload, cause.Error(), next); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to queue %s for incident %s: %v", actionType, incidentID, err))
+		return false
 	}
 
-	return nil, fmt.Errorf("service not found: %s", serviceID)
+	a.logger.Info(fmt.Sprintf("Queued %s for incident %s for retry", actionType, incidentID))
+	runtime.EventsEmit(a.ctx, "pending-actions-updated")
+	return true
 }
 
-func (a *App) cleanupOldSidebarData() {
-	ticker := time.NewTicker(24 * time.Hour) // Run daily
+// pendingActionBackoff doubles the retry interval per attempt, up to
+// pendingActionMaxBackoff
+func pendingActionBackoff(attempts int) time.Duration {
+	backoff := pendingActionRetryInterval
+	for i := 1; i < attempts && backoff < pendingActionMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, pendingActionMaxBackoff)
+}
+
+// retryPendingActions periodically retries queued write operations
+func (a *App) retryPendingActions() {
+	ticker := time.NewTicker(pendingActionRetryInterval)
 	defer ticker.Stop()
 
 	a.shutdownWg.Add(1)
@@ -2109,555 +6837,713 @@ func (a *App) cleanupOldSidebarData() {
 	for {
 		select {
 		case <-a.shutdownChan:
-			a.logger.Info("Cleanup routine stopped by shutdown signal")
+			a.logger.Info("Pending action retry routine stopped by shutdown signal")
 			return
 		case <-ticker.C:
-			// Clean up sidebar data older than 30 days
-			sidebarCutoff := time.Now().Add(-30 * 24 * time.Hour)
-			if err := a.db.CleanupOldSidebarData(sidebarCutoff); err != nil {
-				a.logger.Error(fmt.Sprintf("Failed to cleanup old sidebar data: %v", err))
-			} else {
-				a.logger.Info("Successfully cleaned up old sidebar data")
+			a.processPendingActions()
+		}
+	}
+}
+
+// processPendingActions retries every due action while the circuit breaker is
+// closed. Actions are dropped after a permanent error or too many attempts.
+func (a *App) processPendingActions() {
+	if a.getClient() == nil || a.db == nil || a.isPaused() {
+		return
+	}
+	if a.circuitBreaker != nil && atomic.LoadInt32(&a.circuitBreaker.state) != 0 {
+		return
+	}
+
+	actions, err := a.db.GetDuePendingActions(time.Now())
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to load pending actions: %v", err))
+		return
+	}
+	if len(actions) == 0 {
+		return
+	}
+
+	acknowledged := false
+	for _, action := range actions {
+		err := a.executePendingAction(action)
+		if err == nil {
+			a.logger.Info(fmt.Sprintf("Retried %s for incident %s successfully", action.ActionType, action.IncidentID))
+			if action.ActionType == pendingActionAcknowledge {
+				acknowledged = true
 			}
+			if err := a.db.DeletePendingAction(action.ID); err != nil {
+				a.logger.Error(fmt.Sprintf("Failed to remove pending action: %v", err))
+			}
+			continue
+		}
 
-			// Clean up incidents older than 90 days (3 months)
-			incidentCutoff := time.Now().Add(-90 * 24 * time.Hour)
-			if err := a.db.CleanupOldIncidents(incidentCutoff); err != nil {
-				a.logger.Error(fmt.Sprintf("Failed to cleanup old incidents: %v", err))
-			} else {
-				a.logger.Info("Successfully cleaned up old incidents (older than 90 days)")
+		attempts := action.Attempts + 1
+		if !isRetryableFetchError(err) || attempts >= pendingActionMaxAttempts {
+			a.logger.Error(fmt.Sprintf("Giving up on %s for incident %s after %d attempts: %v",
+				action.ActionType, action.IncidentID, attempts, err))
+			if err := a.db.DeletePendingAction(action.ID); err != nil {
+				a.logger.Error(fmt.Sprintf("Failed to remove pending action: %v", err))
 			}
+			continue
+		}
+
+		a.logger.Warn(fmt.Sprintf("Retry of %s for incident %s failed (attempt %d): %v",
+			action.ActionType, action.IncidentID, attempts, err))
+		next := time.Now().Add(pendingActionBackoff(attempts))
+		if err := a.db.RecordPendingActionFailure(action.ID, err.Error(), next); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to reschedule pending action: %v", err))
+		}
+	}
+
+	if acknowledged {
+		go a.fetchAndUpdateIncidents()
+	}
+	runtime.EventsEmit(a.ctx, "pending-actions-updated")
+}
+
+// executePendingAction performs a queued write operation
+func (a *App) executePendingAction(action database.PendingAction) error {
+	client := a.getClient()
+	if client == nil {
+		return fmt.Errorf("PagerDuty client not initialized")
+	}
+
+	switch action.ActionType {
+	case pendingActionAcknowledge:
+		userEmail, err := a.getUserEmail()
+		if err != nil {
+			return fmt.Errorf("failed to get user email: %w", err)
 		}
+		return client.AcknowledgeIncident(action.IncidentID, userEmail)
+	case pendingActionNote:
+		if err := client.CreateIncidentNote(action.IncidentID, action.Payload); err != nil {
+			return err
+		}
+		if err := a.db.ClearIncidentSidebarCache(action.IncidentID); err != nil {
+			a.logger.Warn(fmt.Sprintf("Failed to clear sidebar cache: %v", err))
+		}
+		runtime.EventsEmit(a.ctx, "sidebar-data-updated", action.IncidentID)
+		return nil
+	default:
+		return fmt.Errorf("unknown pending action type: %s", action.ActionType)
 	}
 }
 
-// to fetch user on startup
-func (a *App) ConfigureAPIKey(
-	apiKey string) error {
-	if apiKey == "" {
-		return fmt.Errorf("API key cannot be empty")
+// GetPendingActions returns write operations waiting to be retried, so the UI
+// can show how many actions are pending sync
+func (a *App) GetPendingActions() ([]database.PendingAction, error) {
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
 	}
 
-	// Initialize PagerDuty client with queue
-	client, err := store.NewClient(apiKey)
-	if err != nil {
-		a.logger.Error(fmt.Sprintf("Failed to create PagerDuty client: %v", err))
-		return fmt.Errorf("failed to create PagerDuty client: %w", err)
-	}
+	return a.db.GetPendingActions()
+}
 
-	// Set custom logger for the client
-	if a.logger != nil {
-		client.SetLogger(func(msg string) {
-			a.logger.Info(msg)
-		})
+// bulkAckRateLimitWait bounds how long AcknowledgeAllOpen waits for rate limit
+// headroom before giving up on an incident
+const bulkAckRateLimitWait = 30 * time.Second
+
+// BulkAcknowledgeResult reports the outcome of AcknowledgeAllOpen
+type BulkAcknowledgeResult struct {
+	Acknowledged int      `json:"acknowledged"`
+	Total        int      `json:"total"`
+	Errors       []string `json:"errors"`
+}
+
+// AcknowledgeAllOpen acknowledges every triggered incident, optionally limited
+// to the given services. Failures are collected per incident rather than
+// aborting the batch. The result is a struct rather than (int, []string)
+// because Wails only passes the first of two non-error return values through.
+func (a *App) AcknowledgeAllOpen(serviceIDs []string) BulkAcknowledgeResult {
+	result := BulkAcknowledgeResult{Errors: []string{}}
+
+	client := a.getClient()
+	if client == nil {
+		result.Errors = append(result.Errors, "PagerDuty client not initialized")
+		return result
+	}
+	if a.db == nil {
+		result.Errors = append(result.Errors, "database not initialized")
+		return result
 	}
 
-	// Test the API key by getting current user and cache the user ID
-	user, err := client.GetCurrentUser()
+	userEmail, err := a.getUserEmail()
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("Failed to validate API key: %v", err))
-		return fmt.Errorf("invalid API key: %w", err)
+		a.logger.Error(fmt.Sprintf("Failed to get user email for bulk acknowledge: %v", err))
+		result.Errors = append(result.Errors, fmt.Sprintf("failed to get user email: %v", err))
+		return result
 	}
 
-	// Save to keyring if available
-	if a.kr != nil {
-		if err := a.kr.Set(keyring.Item{
-			Key:  "pagerduty-api-key",
-			Data: []byte(apiKey),
-		}); err != nil {
-			a.logger.Warn(fmt.Sprintf("Failed to save API key to keyring: %v", err))
-		}
+	openIncidents, err := a.db.GetOpenIncidents()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to load open incidents for bulk acknowledge: %v", err))
+		result.Errors = append(result.Errors, fmt.Sprintf("failed to load open incidents: %v", err))
+		return result
 	}
 
-	// Update client
-	a.client = client
-	a.logger.Info("API key configured successfully")
+	serviceFilter := make(map[string]bool, len(serviceIDs))
+	for _, id := range serviceIDs {
+		serviceFilter[id] = true
+	}
 
-	// Initialize components if not already done
-	if a.userCache == nil {
-		a.userCache = NewUserCache()
+	var triggered []database.IncidentData
+	for _, incident := range openIncidents {
+		if incident.Status != "triggered" {
+			continue
+		}
+		if len(serviceFilter) > 0 && !serviceFilter[incident.ServiceID] {
+			continue
+		}
+		triggered = append(triggered, incident)
 	}
-	if a.circuitBreaker == nil {
-		a.circuitBreaker = NewCircuitBreaker()
+	result.Total = len(triggered)
+
+	if result.Total == 0 {
+		return result
 	}
-	if a.rateLimitTracker == nil {
-		a.rateLimitTracker = NewRateLimitTracker()
+
+	a.logger.Info(fmt.Sprintf("Bulk acknowledging %d incidents as user %s", result.Total, userEmail))
+
+	for _, incident := range triggered {
+		if !a.waitForRateLimit(bulkAckRateLimitWait) {
+			result.Errors = append(result.Errors, fmt.Sprintf("#%d: rate limit reached", incident.IncidentNumber))
+			continue
+		}
+		a.rateLimitTracker.RecordCall()
+
+		if err := client.AcknowledgeIncident(incident.IncidentID, userEmail); err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to acknowledge incident %s: %v", incident.IncidentID, err))
+			result.Errors = append(result.Errors, fmt.Sprintf("#%d: %v", incident.IncidentNumber, err))
+			continue
+		}
+		result.Acknowledged++
 	}
 
-	// Cache the user ID immediately
-	a.userCache.Set(user.ID, user)
-	a.logger.Info(fmt.Sprintf("Cached user ID: %s", user.ID))
+	a.logger.Info(fmt.Sprintf("Bulk acknowledged %d of %d incidents", result.Acknowledged, result.Total))
 
-	// Start polling cycles
-	a.StartPolling()
-	a.StartUserPolling()
-	a.StartResolvedPolling()
+	if result.Acknowledged > 0 {
+		go a.fetchAndUpdateIncidents()
+	}
 
-	// Emit event to notify UI
-	runtime.EventsEmit(a.ctx, "api-key-configured")
+	return result
+}
 
-	return nil
+// waitForRateLimit blocks until the rate limit tracker allows another call,
+// returning false if no headroom appears within maxWait
+func (a *App) waitForRateLimit(maxWait time.Duration) bool {
+	deadline := time.Now().Add(maxWait)
+	for !a.rateLimitTracker.CanMakeCall() {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(time.Second)
+	}
+	return true
 }
 
-func (a *App) GetAPIKey() (
-	string, error,
-) {
-	if a.kr == nil {
-		return "", fmt.Errorf("keyring not available")
+// AddIncidentNote adds a note to an incident via the PagerDuty API
+func (a *App) AddIncidentNote(incidentID string, noteData NoteInput) error {
+	if incidentID == "" {
+		return fmt.Errorf("incident ID is required")
 	}
 
-	item, err := a.kr.Get("pagerduty-api-key")
-	if err != nil {
-		return "", err
+	client := a.getClient()
+	if client == nil {
+		return fmt.Errorf("PagerDuty client not initialized")
 	}
 
-	return string(item.Data), nil
-}
+	// Format the note content from structured data
+	formattedContent := store.FormatNoteContent(noteData.Responses, noteData.Tags, noteData.FreeformContent)
 
-func (a *App) UploadServicesConfig(
-	jsonData string) error {
-	var config store.ServicesConfig
-	if err := json.Unmarshal([]byte(jsonData), &config); err != nil {
-		a.logger.Error(fmt.Sprintf("Failed to parse services config: %v", err))
-		return fmt.Errorf("invalid JSON format: %w", err)
+	// Validate that there is content
+	if strings.TrimSpace(formattedContent) == "" {
+		return fmt.Errorf("note cannot be empty")
 	}
 
-	a.mu.Lock()
-	defer a.mu.Unlock()
+	if !noteData.AllowDuplicate && a.isDuplicateNote(client, incidentID, formattedContent) {
+		a.logger.Warn(fmt.Sprintf("Rejected duplicate note on incident %s", incidentID))
+		return ErrDuplicateNote
+	}
 
-	a.servicesConfig = &config
-	a.selectedServices = []string{}
+	a.logger.Info(fmt.Sprintf("Adding note to incident %s", incidentID))
 
-	// Auto-select all services
-	for _, service := range config.Services {
-		switch id := service.ID.(type) {
-		case string:
-			a.selectedServices = append(a.selectedServices, id)
-		case []interface{}:
-			for _, serviceID := range id {
-				if strID, ok := serviceID.(string); ok {
-					a.selectedServices = append(a.selectedServices, strID)
-				}
-			}
-		case float64:
-			// Handle numeric IDs that come from JSON
-			a.selectedServices = append(a.selectedServices, fmt.Sprintf("%.0f", id))
+	// Call API to create the note
+	err := client.CreateIncidentNote(incidentID, formattedContent)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to add note to incident %s: %v", incidentID, err))
+		if a.queuePendingAction(pendingActionNote, incidentID, formattedContent, err) {
+			return fmt.Errorf("failed to add note, queued for retry: %w", err)
 		}
+		return fmt.Errorf("failed to add note: %w", err)
 	}
 
-	// Invalidate user cache on service change
-	if a.userCache != nil {
-		a.userCache.Invalidate()
-	}
+	a.logger.Info(fmt.Sprintf("Successfully added note to incident %s", incidentID))
 
-	// Trigger immediate refresh
-	go a.fetchAndUpdateIncidents()
-	go a.fetchResolvedIncidentsAdaptive()
+	// Clear sidebar cache for this incident to force refetch
+	// This ensures the new note appears immediately
+	if clearErr := a.db.ClearIncidentSidebarCache(incidentID); clearErr != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to clear sidebar cache: %v", clearErr))
+		// Don't fail the operation if cache clear fails
+	}
 
-	// Emit event to update UI
-	runtime.EventsEmit(a.ctx, "services-config-updated")
+	// Emit event to refresh sidebar
+	runtime.EventsEmit(a.ctx, "sidebar-data-updated", incidentID)
 
 	return nil
 }
 
-func (a *App) RemoveServicesConfig() error {
-	a.mu.Lock()
-	defer a.mu.Unlock()
-
-	a.servicesConfig = nil
-	a.selectedServices = []string{}
+// SearchUsers returns users whose name or email contains query, for assignee
+// and responder pickers. The full user list is cached and filtered locally so
+// typeahead doesn't call the API on every keystroke.
+func (a *App) SearchUsers(query string) ([]store.UserSummary, error) {
+	client := a.getClient()
+	if client == nil {
+		return nil, fmt.Errorf("PagerDuty client not initialized")
+	}
 
-	a.logger.Info("Services configuration removed")
+	users, ok := a.userDirectory.Get()
+	if !ok {
+		var err error
+		users, err = client.ListUsers("")
+		if err != nil {
+			a.logger.Error(fmt.Sprintf("Failed to list users: %v", err))
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+		a.userDirectory.Set(users)
+		a.logger.Info(fmt.Sprintf("Cached %d users", len(users)))
+	}
 
-	// Emit event to update UI
-	runtime.EventsEmit(a.ctx, "services-config-updated")
+	query = strings.ToLower(strings.TrimSpace(query))
+	matches := []store.UserSummary{}
+	for _, user := range users {
+		if strings.Contains(strings.ToLower(user.Name), query) || strings.Contains(strings.ToLower(user.Email), query) {
+			matches = append(matches, user)
+		}
+	}
 
-	return nil
+	return matches, nil
 }
 
-func (a *App) GetServicesConfig() (
-	*store.ServicesConfig, error,
-) {
-	a.mu.RLock()
-	defer a.mu.RUnlock()
+// AddResponders asks the given users to join an incident without changing its
+// assignment
+func (a *App) AddResponders(incidentID string, userIDs []string, message string) error {
+	if incidentID == "" {
+		return fmt.Errorf("incident ID is required")
+	}
 
-	if a.servicesConfig == nil {
-		return nil, fmt.Errorf("no services configuration loaded")
+	if len(userIDs) == 0 {
+		return fmt.Errorf("at least one responder is required")
 	}
-	return a.servicesConfig, nil
-}
 
-func (a *App) GetServiceNameByID(serviceID string) string {
-	a.mu.RLock()
-	defer a.mu.RUnlock()
+	client := a.getClient()
+	if client == nil {
+		return fmt.Errorf("PagerDuty client not initialized")
+	}
 
-	if a.servicesConfig == nil {
-		return ""
+	userEmail, err := a.getUserEmail()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to get user email for responder request: %v", err))
+		return fmt.Errorf("failed to get user email: %w", err)
 	}
 
-	for _, service := range a.servicesConfig.Services {
-		switch id := service.ID.(type) {
-		case string:
-			if id == serviceID {
-				return service.Name
-			}
-		case []interface{}:
-			for _, sid := range id {
-				if strID, ok := sid.(string); ok && strID == serviceID {
-					return service.Name
-				}
-			}
-		case float64:
-			if fmt.Sprintf("%.0f", id) == serviceID {
-				return service.Name
-			}
+	// getUserEmail refreshed the cache if needed, so the ID is normally present
+	requesterID, valid := a.userCache.Get()
+	if !valid {
+		user, err := client.GetCurrentUser()
+		if err != nil {
+			return fmt.Errorf("failed to get current user: %w", err)
 		}
+		a.userCache.Set(user.ID, user)
+		requesterID = user.ID
 	}
 
-	return ""
-}
-
-func (a *App) SetSelectedServices(
-	services []string,
-) {
-	a.mu.Lock()
-	oldServices := a.selectedServices
-	a.selectedServices = services
-	a.mu.Unlock()
+	a.logger.Info(fmt.Sprintf("Requesting %d responder(s) for incident %s", len(userIDs), incidentID))
 
-	// Check if services actually changed
-	if !slicesEqual(oldServices, services) {
-		a.logger.Debug(fmt.Sprintf("Selected services updated: %d services", len(services)))
+	if err := client.AddResponders(incidentID, userIDs, message, requesterID, userEmail); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to add responders to incident %s: %v", incidentID, err))
+		return fmt.Errorf("failed to add responders: %w", err)
+	}
 
-		// Invalidate user cache on service change
-		if a.userCache != nil {
-			a.userCache.Invalidate()
-		}
+	a.logger.Info(fmt.Sprintf("Successfully requested responders for incident %s", incidentID))
 
-		// Trigger immediate refresh for both open and resolved
-		go a.fetchAndUpdateIncidents()
-		go a.fetchResolvedIncidentsAdaptive()
+	// Responder requests add log entries and often a note, so refetch the sidebar
+	if clearErr := a.db.ClearIncidentSidebarCache(incidentID); clearErr != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to clear sidebar cache: %v", clearErr))
 	}
+	runtime.EventsEmit(a.ctx, "sidebar-data-updated", incidentID)
+
+	return nil
 }
 
-func (a *App) GetSelectedServices() []string {
-	a.mu.RLock()
-	defer a.mu.RUnlock()
+// GetIncidentResponders returns the users asked to join an incident and
+// whether they have accepted
+func (a *App) GetIncidentResponders(incidentID string) ([]store.Responder, error) {
+	if incidentID == "" {
+		return nil, fmt.Errorf("incident ID is required")
+	}
 
-	return append([]string{}, a.selectedServices...)
-}
+	client := a.getClient()
+	if client == nil {
+		return nil, fmt.Errorf("PagerDuty client not initialized")
+	}
 
-func (a *App) ReadFile(
-	path string) (
-	string, error,
-) {
-	content, err := os.ReadFile(path)
+	responders, err := client.GetIncidentResponders(incidentID)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("Failed to read file %s: %v", path, err))
-		return "", err
+		a.logger.Error(fmt.Sprintf("Failed to fetch responders for incident %s: %v", incidentID, err))
+		return nil, fmt.Errorf("failed to fetch responders: %w", err)
 	}
-	return string(content), nil
+
+	return responders, nil
 }
 
-func (a *App) GetRateLimitStatus() map[string]interface{} {
-	currentRate := a.rateLimitTracker.GetCurrentRate()
-	status := map[string]interface{}{
-		"current":    currentRate,
-		"max":        960,
-		"remaining":  960 - currentRate,
-		"percentage": float64(currentRate) / 960.0 * 100,
+// ResolveIncident resolves an incident via the PagerDuty API
+func (a *App) ResolveIncident(incidentID string) error {
+	if incidentID == "" {
+		return fmt.Errorf("incident ID is required")
 	}
 
-	if a.circuitBreaker != nil {
-		status["circuit_breaker"] = map[string]interface{}{
-			"state":    atomic.LoadInt32(&a.circuitBreaker.state),
-			"failures": atomic.LoadInt32(&a.circuitBreaker.failures),
+	client := a.getClient()
+	if client == nil {
+		return fmt.Errorf("PagerDuty client not initialized")
+	}
+
+	// Get current user's email
+	userEmail, err := a.getUserEmail()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to get user email for resolve: %v", err))
+		return fmt.Errorf("failed to get user email: %w", err)
+	}
+
+	a.logger.Info(fmt.Sprintf("Resolving incident %s as user %s", incidentID, userEmail))
+
+	// Call API to resolve incident
+	err = client.ResolveIncident(incidentID, userEmail)
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to resolve incident %s: %v", incidentID, err))
+		return fmt.Errorf("failed to resolve incident: %w", err)
+	}
+
+	a.logger.Info(fmt.Sprintf("Successfully resolved incident %s", incidentID))
+
+	// Mark the local row resolved so it leaves the open list immediately
+	if a.db != nil {
+		if err := a.markResolvedLocally(incidentID); err != nil {
+			a.logger.Warn(fmt.Sprintf("Failed to mark incident %s resolved locally: %v", incidentID, err))
 		}
+		runtime.EventsEmit(a.ctx, "incidents-updated", "both")
 	}
 
-	return status
-}
+	// Trigger immediate fetch to update UI quickly
+	// The polling will also pick this up, but this provides instant feedback
+	go a.fetchAndUpdateIncidents()
+	go func() {
+		// Small delay to let PagerDuty process the change
+		time.Sleep(1 * time.Second)
+		a.fetchResolvedIncidentsSince()
+	}()
 
-func (a *App) GetNotificationConfig() NotificationConfig {
-	if a.notificationMgr == nil {
-		return NotificationConfig{}
-	}
-	return a.notificationMgr.GetConfig()
+	return nil
 }
 
-func (a *App) SetNotificationEnabled(enabled bool) {
-	if a.notificationMgr != nil {
-		a.notificationMgr.SetEnabled(enabled)
+// ReopenIncident sets a resolved incident back to triggered. PagerDuty only
+// allows this for a limited time after resolution; a rejection is returned as
+// an error.
+func (a *App) ReopenIncident(incidentID string) error {
+	if incidentID == "" {
+		return fmt.Errorf("incident ID is required")
 	}
-}
 
-func (a *App) SetNotificationSound(sound string) {
-	if a.notificationMgr != nil {
-		a.notificationMgr.SetSound(sound)
+	client := a.getClient()
+	if client == nil {
+		return fmt.Errorf("PagerDuty client not initialized")
 	}
-}
 
-func (a *App) TestNotificationSound() error {
-	if a.notificationMgr != nil {
-		return a.notificationMgr.TestSound()
+	if a.db == nil {
+		return fmt.Errorf("database not initialized")
 	}
-	return fmt.Errorf("notification manager not initialized")
-}
 
-func (a *App) GetAvailableSounds() []string {
-	if a.notificationMgr != nil {
-		sounds, err := a.notificationMgr.GetAvailableSounds()
-		if err != nil {
-			a.logger.Warn(fmt.Sprintf("Failed to get available sounds: %v", err))
-			return []string{"default"}
-		}
-		return sounds
+	incident, err := a.db.GetIncidentByID(incidentID)
+	if err != nil {
+		return err
+	}
+	if incident.Status != "resolved" {
+		return fmt.Errorf("incident %s is %s, only resolved incidents can be reopened", incidentID, incident.Status)
 	}
-	return []string{"default"}
-}
 
-func (a *App) SnoozeNotificationSound(minutes int) {
-	if a.notificationMgr != nil {
-		a.notificationMgr.SnoozeSound(minutes)
-		runtime.EventsEmit(a.ctx, "notification-snoozed", minutes)
+	userEmail, err := a.getUserEmail()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to get user email for reopen: %v", err))
+		return fmt.Errorf("failed to get user email: %w", err)
 	}
-}
 
-func (a *App) UnsnoozeNotificationSound() {
-	if a.notificationMgr != nil {
-		a.notificationMgr.UnsnoozeSound()
-		runtime.EventsEmit(a.ctx, "notification-unsnoozed")
+	a.logger.Info(fmt.Sprintf("Reopening incident %s as user %s", incidentID, userEmail))
+
+	if err := client.ReopenIncident(incidentID, userEmail); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to reopen incident %s: %v", incidentID, err))
+		return err
 	}
-}
 
-func (a *App) IsNotificationSnoozed() bool {
-	if a.notificationMgr != nil {
-		return a.notificationMgr.IsSnoozeActive()
+	a.logger.Info(fmt.Sprintf("Successfully reopened incident %s", incidentID))
+
+	if err := a.db.ReopenIncident(incidentID); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to mark incident %s reopened locally: %v", incidentID, err))
 	}
-	return false
-}
 
-func (a *App) shutdown(ctx context.Context) {
-	a.logger.Info("Shutting down PagerOps...")
+	// The user reopened it themselves, so don't notify it as newly triggered
+	a.lastIncidentsMu.Lock()
+	a.lastIncidents[incidentID] = "triggered"
+	a.lastIncidentsMu.Unlock()
 
-	// First, stop all polling to prevent new operations
-	a.StopPolling()
-	a.StopUserPolling()
-	a.StopResolvedPolling()
+	runtime.EventsEmit(a.ctx, "incidents-updated", "both")
 
-	// Then signal shutdown to running goroutines
-	close(a.shutdownChan)
+	go a.fetchAndUpdateIncidents()
 
-	// Shutdown notification manager
-	if a.notificationMgr != nil {
-		a.notificationMgr.Shutdown()
-	}
+	return nil
+}
 
-	// Wait for goroutines with timeout
-	done := make(chan struct{})
-	go func() {
-		a.shutdownWg.Wait()
-		close(done)
-	}()
+// MarkIncidentResolvedLocally marks an incident resolved in the local database
+// without calling the API, for incidents resolved outside the app (e.g. in the
+// PagerDuty web UI). If the next poll still reports it open, the poll's upsert
+// restores its status and it reappears in the open list.
+func (a *App) MarkIncidentResolvedLocally(incidentID string) error {
+	if incidentID == "" {
+		return fmt.Errorf("incident ID is required")
+	}
 
-	select {
-	case <-done:
-		a.logger.Info("All goroutines stopped")
-	case <-time.After(10 * time.Second):
-		a.logger.Warn("Shutdown timeout - some goroutines may not have stopped")
+	if a.db == nil {
+		return fmt.Errorf("database not initialized")
 	}
 
-	// Shutdown the client API queue
-	if a.client != nil {
-		a.client.Shutdown()
+	if err := a.markResolvedLocally(incidentID); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to mark incident %s resolved locally: %v", incidentID, err))
+		return err
 	}
 
-	// Close database
-	if a.db != nil {
-		if err := a.db.Close(); err != nil {
-			a.logger.Error(fmt.Sprintf("Failed to close database: %v", err))
-		}
+	a.logger.Info(fmt.Sprintf("Marked incident %s resolved locally", incidentID))
+	runtime.EventsEmit(a.ctx, "incidents-updated", "both")
+	return nil
+}
+
+// ToggleIncidentWatch flips an incident's watched flag and returns the new value
+func (a *App) ToggleIncidentWatch(incidentID string) (bool, error) {
+	if incidentID == "" {
+		return false, fmt.Errorf("incident ID is required")
 	}
 
-	// Close logger
-	if a.logger != nil {
-		a.logger.Close()
+	if a.db == nil {
+		return false, fmt.Errorf("database not initialized")
 	}
-}
 
-// Helper function to compare slices
-func slicesEqual(a, b []string) bool {
-	if len(a) != len(b) {
-		return false
+	incident, err := a.db.GetIncidentByID(incidentID)
+	if err != nil {
+		return false, err
 	}
-	for i, v := range a {
-		if v != b[i] {
-			return false
-		}
+
+	watched := !incident.Watched
+	if err := a.db.SetIncidentWatched(incidentID, watched); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to update watch for incident %s: %v", incidentID, err))
+		return false, err
 	}
-	return true
-}
 
-// NoteInput represents the structured note data from the frontend
-type NoteInput struct {
-	Responses       []store.NoteResponse `json:"responses"`
-	Tags            []store.NoteTag      `json:"tags"`
-	FreeformContent string               `json:"freeform_content"`
+	a.logger.Info(fmt.Sprintf("Incident %s watched: %t", incidentID, watched))
+	runtime.EventsEmit(a.ctx, "incidents-updated", "both")
+	return watched, nil
 }
 
-// getUserEmail retrieves the current user's email from cache
-func (a *App) getUserEmail() (string, error) {
-	if a.userCache == nil {
-		return "", fmt.Errorf("user cache not initialized")
+// GetWatchedIncidents returns the incidents the user is watching
+func (a *App) GetWatchedIncidents() ([]database.IncidentData, error) {
+	if a.db == nil {
+		return nil, fmt.Errorf("database not initialized")
 	}
 
-	// Try to get from cache first
-	a.userCache.mu.RLock()
-	user := a.userCache.user
-	a.userCache.mu.RUnlock()
+	incidents, err := a.db.GetWatchedIncidents()
+	if err != nil {
+		return nil, err
+	}
+	if incidents == nil {
+		incidents = []database.IncidentData{}
+	}
+	return incidents, nil
+}
 
-	if user != nil {
-		if pdUser, ok := user.(*pagerduty.User); ok && pdUser.Email != "" {
-			return pdUser.Email, nil
-		}
+// OpenIncidentInBrowser opens an incident's PagerDuty page in the default browser
+func (a *App) OpenIncidentInBrowser(incidentID string) error {
+	if incidentID == "" {
+		return fmt.Errorf("incident ID is required")
 	}
 
-	// If not in cache or no email, fetch fresh user data
-	if a.client == nil {
-		return "", fmt.Errorf("PagerDuty client not initialized")
+	if a.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	if a.notificationMgr == nil {
+		return fmt.Errorf("notification manager not initialized")
 	}
 
-	freshUser, err := a.client.GetCurrentUser()
+	incident, err := a.db.GetIncidentByID(incidentID)
 	if err != nil {
-		return "", fmt.Errorf("failed to get current user: %w", err)
+		return fmt.Errorf("incident %s not found: %w", incidentID, err)
+	}
+	if incident.HTMLURL == "" {
+		return fmt.Errorf("incident %s has no URL", incidentID)
 	}
 
-	// Update cache with fresh data
-	a.userCache.Set(freshUser.ID, freshUser)
+	if err := a.notificationMgr.openInBrowser(incident.HTMLURL); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to open incident %s in browser: %v", incidentID, err))
+		return fmt.Errorf("failed to open browser: %w", err)
+	}
 
-	if freshUser.Email == "" {
-		return "", fmt.Errorf("user email not available")
+	return nil
+}
+
+// markResolvedLocally sets an incident's stored status to resolved
+func (a *App) markResolvedLocally(incidentID string) error {
+	incident, err := a.db.GetIncidentByID(incidentID)
+	if err != nil {
+		return err
+	}
+	if incident.Status == "resolved" {
+		return nil
 	}
 
-	return freshUser.Email, nil
+	// updated_at is left alone so a later poll that still reports the
+	// incident open (with the same updated_at) can restore it
+	now := time.Now()
+	incident.Status = "resolved"
+	incident.ResolvedAt = &now
+	return a.db.UpsertIncident(incident)
 }
 
-// AcknowledgeIncident acknowledges an incident via the PagerDuty API
-func (a *App) AcknowledgeIncident(incidentID string) error {
+// SetIncidentPriority sets an incident's priority via the PagerDuty API
+func (a *App) SetIncidentPriority(incidentID, priorityID string) error {
 	if incidentID == "" {
 		return fmt.Errorf("incident ID is required")
 	}
+	if priorityID == "" {
+		return fmt.Errorf("priority ID is required")
+	}
 
-	if a.client == nil {
+	client := a.getClient()
+	if client == nil {
 		return fmt.Errorf("PagerDuty client not initialized")
 	}
 
 	// Get current user's email
 	userEmail, err := a.getUserEmail()
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("Failed to get user email for acknowledge: %v", err))
+		a.logger.Error(fmt.Sprintf("Failed to get user email for priority update: %v", err))
 		return fmt.Errorf("failed to get user email: %w", err)
 	}
 
-	a.logger.Info(fmt.Sprintf("Acknowledging incident %s as user %s", incidentID, userEmail))
+	a.logger.Info(fmt.Sprintf("Setting priority %s on incident %s as user %s", priorityID, incidentID, userEmail))
 
-	// Call API to acknowledge incident
-	err = a.client.AcknowledgeIncident(incidentID, userEmail)
-	if err != nil {
-		a.logger.Error(fmt.Sprintf("Failed to acknowledge incident %s: %v", incidentID, err))
-		return fmt.Errorf("failed to acknowledge incident: %w", err)
+	if err := client.SetIncidentPriority(incidentID, priorityID, userEmail); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to set priority on incident %s: %v", incidentID, err))
+		return fmt.Errorf("failed to set incident priority: %w", err)
 	}
 
-	a.logger.Info(fmt.Sprintf("Successfully acknowledged incident %s", incidentID))
+	a.logger.Info(fmt.Sprintf("Successfully set priority on incident %s", incidentID))
 
-	// Trigger immediate fetch to update UI quickly
-	// The polling will also pick this up, but this provides instant feedback
+	// Trigger immediate fetch so the new priority is stored and shown
 	go a.fetchAndUpdateIncidents()
 
 	return nil
 }
 
-// AddIncidentNote adds a note to an incident via the PagerDuty API
-func (a *App) AddIncidentNote(incidentID string, noteData NoteInput) error {
+// GetPriorities returns the account's incident priorities for the priority dropdown
+func (a *App) GetPriorities() ([]store.Priority, error) {
+	client := a.getClient()
+	if client == nil {
+		return nil, fmt.Errorf("PagerDuty client not initialized")
+	}
+
+	priorities, err := client.ListPriorities()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to list priorities: %v", err))
+		return nil, err
+	}
+
+	return priorities, nil
+}
+
+// SnoozeIncident snoozes an incident via the PagerDuty API. The incident stays
+// acknowledged and re-triggers once the snooze expires.
+func (a *App) SnoozeIncident(incidentID string, durationSeconds int) error {
 	if incidentID == "" {
 		return fmt.Errorf("incident ID is required")
 	}
+	if durationSeconds <= 0 {
+		return fmt.Errorf("snooze duration must be positive")
+	}
 
-	if a.client == nil {
+	client := a.getClient()
+	if client == nil {
 		return fmt.Errorf("PagerDuty client not initialized")
 	}
 
-	// Format the note content from structured data
-	formattedContent := store.FormatNoteContent(noteData.Responses, noteData.Tags, noteData.FreeformContent)
-
-	// Validate that there is content
-	if strings.TrimSpace(formattedContent) == "" {
-		return fmt.Errorf("note cannot be empty")
+	duration := time.Duration(durationSeconds) * time.Second
+	if duration > store.MaxSnoozeDuration {
+		a.logger.Warn(fmt.Sprintf("Snooze duration %v exceeds PagerDuty maximum, capping to %v", duration, store.MaxSnoozeDuration))
+		duration = store.MaxSnoozeDuration
 	}
 
-	a.logger.Info(fmt.Sprintf("Adding note to incident %s", incidentID))
-
-	// Call API to create the note
-	err := a.client.CreateIncidentNote(incidentID, formattedContent)
+	// Get current user's email
+	userEmail, err := a.getUserEmail()
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("Failed to add note to incident %s: %v", incidentID, err))
-		return fmt.Errorf("failed to add note: %w", err)
+		a.logger.Error(fmt.Sprintf("Failed to get user email for snooze: %v", err))
+		return fmt.Errorf("failed to get user email: %w", err)
 	}
 
-	a.logger.Info(fmt.Sprintf("Successfully added note to incident %s", incidentID))
+	a.logger.Info(fmt.Sprintf("Snoozing incident %s for %v as user %s", incidentID, duration, userEmail))
 
-	// Clear sidebar cache for this incident to force refetch
-	// This ensures the new note appears immediately
-	if clearErr := a.db.ClearIncidentSidebarCache(incidentID); clearErr != nil {
-		a.logger.Warn(fmt.Sprintf("Failed to clear sidebar cache: %v", clearErr))
-		// Don't fail the operation if cache clear fails
+	if err := client.SnoozeIncident(incidentID, duration, userEmail); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to snooze incident %s: %v", incidentID, err))
+		return fmt.Errorf("failed to snooze incident: %w", err)
 	}
 
-	// Emit event to refresh sidebar
-	runtime.EventsEmit(a.ctx, "sidebar-data-updated", incidentID)
+	a.logger.Info(fmt.Sprintf("Successfully snoozed incident %s", incidentID))
+
+	// Refresh so the incident shows as acknowledged, then notify the UI
+	go func() {
+		a.fetchAndUpdateIncidents()
+		runtime.EventsEmit(a.ctx, "incidents-updated", "both")
+	}()
 
 	return nil
 }
 
-// ResolveIncident resolves an incident via the PagerDuty API
-func (a *App) ResolveIncident(incidentID string) error {
+// GetOnCallForIncident returns who is currently on call for the incident's service
+func (a *App) GetOnCallForIncident(incidentID string) ([]store.OnCallEntry, error) {
 	if incidentID == "" {
-		return fmt.Errorf("incident ID is required")
+		return nil, fmt.Errorf("incident ID is required")
 	}
 
-	if a.client == nil {
-		return fmt.Errorf("PagerDuty client not initialized")
+	client := a.getClient()
+	if client == nil {
+		return nil, fmt.Errorf("PagerDuty client not initialized")
 	}
 
-	// Get current user's email
-	userEmail, err := a.getUserEmail()
+	incident, err := a.db.GetIncidentByID(incidentID)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("Failed to get user email for resolve: %v", err))
-		return fmt.Errorf("failed to get user email: %w", err)
+		return nil, fmt.Errorf("failed to look up incident: %w", err)
+	}
+	if incident.ServiceID == "" {
+		return nil, fmt.Errorf("incident %s has no service", incidentID)
 	}
 
-	a.logger.Info(fmt.Sprintf("Resolving incident %s as user %s", incidentID, userEmail))
+	if onCalls, ok := a.onCallCache.Get(incident.ServiceID); ok {
+		return onCalls, nil
+	}
 
-	// Call API to resolve incident
-	err = a.client.ResolveIncident(incidentID, userEmail)
+	onCalls, err := client.GetOnCallsForService(incident.ServiceID)
 	if err != nil {
-		a.logger.Error(fmt.Sprintf("Failed to resolve incident %s: %v", incidentID, err))
-		return err
+		a.logger.Error(fmt.Sprintf("Failed to fetch on-calls for service %s: %v", incident.ServiceID, err))
+		return nil, fmt.Errorf("failed to fetch on-calls: %w", err)
 	}
 
-	a.logger.Info(fmt.Sprintf("Successfully resolved incident %s", incidentID))
-
-	// Force immediate refresh of both incident lists
-	go func() {
-		// Small delay to let PagerDuty process the change
-		time.Sleep(1 * time.Second)
-		a.fetchServiceIncidents()
-		a.fetchUserIncidents()
-		time.Sleep(500 * time.Millisecond)
-		a.fetchResolvedIncidentsSince()
-	}()
-
-	return nil
+	a.onCallCache.Set(incident.ServiceID, onCalls)
+	return onCalls, nil
 }
 
 // GetIncidentCustomFields returns the incident custom field definitions merged
@@ -2667,11 +7553,12 @@ func (a *App) GetIncidentCustomFields(incidentID string) ([]store.CustomField, e
 		return nil, fmt.Errorf("incident ID is required")
 	}
 
-	if a.client == nil {
+	client := a.getClient()
+	if client == nil {
 		return nil, fmt.Errorf("PagerDuty client not initialized")
 	}
 
-	fields, err := a.client.GetIncidentCustomFields(incidentID)
+	fields, err := client.GetIncidentCustomFields(incidentID)
 	if err != nil {
 		a.logger.Error(fmt.Sprintf("Failed to get custom fields for %s: %v", incidentID, err))
 		return nil, err
@@ -2687,11 +7574,12 @@ func (a *App) GetIncidentCustomFieldValues(incidentID string) ([]store.CustomFie
 		return nil, fmt.Errorf("incident ID is required")
 	}
 
-	if a.client == nil {
+	client := a.getClient()
+	if client == nil {
 		return nil, fmt.Errorf("PagerDuty client not initialized")
 	}
 
-	values, err := a.client.GetIncidentCustomFieldValues(incidentID)
+	values, err := client.GetIncidentCustomFieldValues(incidentID)
 	if err != nil {
 		a.logger.Error(fmt.Sprintf("Failed to get custom field values for %s: %v", incidentID, err))
 		return nil, err
@@ -2709,7 +7597,8 @@ func (a *App) SetIncidentCustomFieldValue(incidentID, fieldID string, value inte
 		return fmt.Errorf("field ID is required")
 	}
 
-	if a.client == nil {
+	client := a.getClient()
+	if client == nil {
 		return fmt.Errorf("PagerDuty client not initialized")
 	}
 
@@ -2722,7 +7611,7 @@ func (a *App) SetIncidentCustomFieldValue(incidentID, fieldID string, value inte
 
 	a.logger.Info(fmt.Sprintf("Setting custom field %s on incident %s", fieldID, incidentID))
 
-	if err := a.client.SetIncidentCustomFieldValue(incidentID, fieldID, value, userEmail); err != nil {
+	if err := client.SetIncidentCustomFieldValue(incidentID, fieldID, value, userEmail); err != nil {
 		a.logger.Error(fmt.Sprintf("Failed to set custom field %s on incident %s: %v", fieldID, incidentID, err))
 		return err
 	}
@@ -0,0 +1,107 @@
+package database
+
+import (
+	"fmt"
+)
+
+// migration adds a column to a table created by an older app version. Fresh
+// databases already get the column from CREATE TABLE, so each step must be
+// idempotent.
+type migration struct {
+	version     int
+	description string
+	table       string
+	column      string
+	columnType  string
+}
+
+// migrations are applied in order. Append new steps with the next version;
+// never renumber or remove existing ones.
+var migrations = []migration{
+	{1, "add incidents.acknowledged_by", "incidents", "acknowledged_by", "TEXT DEFAULT ''"},
+	{2, "add incidents.priority_id", "incidents", "priority_id", "TEXT DEFAULT ''"},
+	{3, "add incidents.priority_name", "incidents", "priority_name", "TEXT DEFAULT ''"},
+	{4, "add incidents.acknowledged_at", "incidents", "acknowledged_at", "DATETIME"},
+	{5, "add incidents.resolved_at", "incidents", "resolved_at", "DATETIME"},
+	{6, "add incidents.assignees", "incidents", "assignees", "TEXT DEFAULT ''"},
+	{7, "add incidents.watched", "incidents", "watched", "INTEGER DEFAULT 0"},
+	{8, "add incident_alerts.description", "incident_alerts", "description", "TEXT"},
+	{9, "add incident_sidebar_metadata.last_fetched_log_entries", "incident_sidebar_metadata", "last_fetched_log_entries", "DATETIME"},
+	{10, "add incident_sidebar_metadata.triggered_alert_count", "incident_sidebar_metadata", "triggered_alert_count", "INTEGER DEFAULT 0"},
+	{11, "add incident_sidebar_metadata.resolved_alert_count", "incident_sidebar_metadata", "resolved_alert_count", "INTEGER DEFAULT 0"},
+	{12, "add incidents.acked_by", "incidents", "acked_by", "TEXT DEFAULT ''"},
+	{13, "add incident_sidebar_metadata.responders", "incident_sidebar_metadata", "responders", "TEXT DEFAULT ''"},
+}
+
+// runMigrations applies every migration newer than the recorded schema
+// version, recording each one in schema_migrations as it succeeds
+func (db *DB) runMigrations() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	migrationsTable := `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		description TEXT,
+		applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+	`
+	if _, err := db.conn.Exec(migrationsTable); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	current, err := db.schemaVersion()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+
+		if err := db.ensureColumn(m.table, m.column, m.columnType); err != nil {
+			return fmt.Errorf("migration %d (%s) failed: %w", m.version, m.description, err)
+		}
+
+		_, err := db.conn.Exec(
+			`INSERT INTO schema_migrations (version, description) VALUES (?, ?)`,
+			m.version, m.description,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record migration %d: %w", m.version, err)
+		}
+
+		db.appliedMigrations = append(db.appliedMigrations, fmt.Sprintf("%d: %s", m.version, m.description))
+	}
+
+	return nil
+}
+
+// schemaVersion returns the highest applied migration version. Callers must
+// hold db.mu.
+func (db *DB) schemaVersion() (int, error) {
+	var version int
+	err := db.conn.QueryRow(`SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&version)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return version, nil
+}
+
+// SchemaVersion returns the current schema version
+func (db *DB) SchemaVersion() (int, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.schemaVersion()
+}
+
+// AppliedMigrations lists the migrations applied when the database was opened,
+// so the caller can log them
+func (db *DB) AppliedMigrations() []string {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return append([]string{}, db.appliedMigrations...)
+}
@@ -0,0 +1,156 @@
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// PendingAction is a write operation (acknowledge, note) that failed with a
+// transient error and is waiting to be retried
+type PendingAction struct {
+	ID            int64     `json:"id"`
+	ActionType    string    `json:"action_type"`
+	IncidentID    string    `json:"incident_id"`
+	Payload       string    `json:"payload"`
+	Attempts      int       `json:"attempts"`
+	LastError     string    `json:"last_error"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// createPendingActionsTable creates the retry queue for failed write operations
+func (db *DB) createPendingActionsTable() error {
+	pendingActionsTable := `
+	CREATE TABLE IF NOT EXISTS pending_actions (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		action_type TEXT NOT NULL,
+		incident_id TEXT NOT NULL,
+		payload TEXT DEFAULT '',
+		attempts INTEGER DEFAULT 0,
+		last_error TEXT DEFAULT '',
+		next_attempt_at DATETIME,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_pending_actions_next ON pending_actions(next_attempt_at);
+	`
+
+	if _, err := db.conn.Exec(pendingActionsTable); err != nil {
+		return fmt.Errorf("failed to create pending_actions table: %w", err)
+	}
+
+	return nil
+}
+
+// AddPendingAction queues a failed write operation for retry
+func (db *DB) AddPendingAction(actionType, incidentID, payload, lastError string, nextAttempt time.Time) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	query := `
+		INSERT INTO pending_actions (action_type, incident_id, payload, attempts, last_error, next_attempt_at, created_at)
+		VALUES (?, ?, ?, 1, ?, ?, ?)
+	`
+
+	_, err := db.conn.Exec(query, actionType, incidentID, payload, lastError, nextAttempt, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to add pending action: %w", err)
+	}
+
+	return nil
+}
+
+// GetPendingActions returns every queued action, oldest first
+func (db *DB) GetPendingActions() ([]PendingAction, error) {
+	return db.queryPendingActions(`
+		SELECT id, action_type, incident_id, COALESCE(payload, ''), attempts,
+			COALESCE(last_error, ''), next_attempt_at, created_at
+		FROM pending_actions
+		ORDER BY created_at ASC, id ASC
+	`)
+}
+
+// GetDuePendingActions returns queued actions whose next attempt is due
+func (db *DB) GetDuePendingActions(now time.Time) ([]PendingAction, error) {
+	return db.queryPendingActions(`
+		SELECT id, action_type, incident_id, COALESCE(payload, ''), attempts,
+			COALESCE(last_error, ''), next_attempt_at, created_at
+		FROM pending_actions
+		WHERE next_attempt_at <= ?
+		ORDER BY created_at ASC, id ASC
+	`, now)
+}
+
+func (db *DB) queryPendingActions(query string, args ...interface{}) ([]PendingAction, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	rows, err := db.conn.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pending actions: %w", err)
+	}
+	defer rows.Close()
+
+	actions := []PendingAction{}
+	for rows.Next() {
+		var action PendingAction
+		err := rows.Scan(
+			&action.ID,
+			&action.ActionType,
+			&action.IncidentID,
+			&action.Payload,
+			&action.Attempts,
+			&action.LastError,
+			&action.NextAttemptAt,
+			&action.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pending action: %w", err)
+		}
+		actions = append(actions, action)
+	}
+
+	return actions, rows.Err()
+}
+
+// RecordPendingActionFailure counts a failed retry and schedules the next one
+func (db *DB) RecordPendingActionFailure(id int64, lastError string, nextAttempt time.Time) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	query := `
+		UPDATE pending_actions
+		SET attempts = attempts + 1, last_error = ?, next_attempt_at = ?
+		WHERE id = ?
+	`
+
+	if _, err := db.conn.Exec(query, lastError, nextAttempt, id); err != nil {
+		return fmt.Errorf("failed to update pending action %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// DeletePendingAction removes an action once it succeeded or was given up on
+func (db *DB) DeletePendingAction(id int64) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if _, err := db.conn.Exec(`DELETE FROM pending_actions WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete pending action %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// ClearPendingActions drops every queued action
+func (db *DB) ClearPendingActions() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if _, err := db.conn.Exec(`DELETE FROM pending_actions`); err != nil {
+		return fmt.Errorf("failed to clear pending actions: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package database
+
+import (
+	"errors"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// Writes that hit SQLITE_BUSY/SQLITE_LOCKED under contention are retried this
+// many times, doubling the delay from lockRetryDelay each attempt
+const (
+	lockRetryAttempts = 4
+	lockRetryDelay    = 25 * time.Millisecond
+)
+
+// isLockedError reports whether err is SQLite refusing a write because
+// another connection holds the database lock
+func isLockedError(err error) bool {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+}
+
+// retryOnLock runs write, retrying with a short backoff while the database is
+// locked. Any other error is returned immediately.
+func retryOnLock(write func() error) error {
+	delay := lockRetryDelay
+	var err error
+	for attempt := 1; attempt <= lockRetryAttempts; attempt++ {
+		err = write()
+		if err == nil || !isLockedError(err) || attempt == lockRetryAttempts {
+			return err
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+	return err
+}
@@ -3,6 +3,7 @@ package database
 import (
 	"database/sql"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -13,7 +14,11 @@ import (
 // DB represents the database connection - NO CHANGES TO EXISTING STRUCT
 type DB struct {
 	conn *sql.DB
+	path string
 	mu   sync.RWMutex // Added for thread safety
+
+	// appliedMigrations describes the migrations run when the DB was opened
+	appliedMigrations []string
 }
 
 // IncidentData represents an incident from PagerDuty - NO CHANGES TO EXISTING STRUCT
@@ -30,6 +35,21 @@ type IncidentData struct {
 	AlertCount     int       `json:"alert_count"`
 	Urgency        string    `json:"urgency"`
 	AcknowledgedBy string    `json:"acknowledged_by"`
+	PriorityID     string    `json:"priority_id"`
+	PriorityName   string    `json:"priority_name"`
+	// AcknowledgedAt and ResolvedAt record when the incident was first seen in
+	// each status. They are nil until that transition has been observed.
+	AcknowledgedAt *time.Time `json:"acknowledged_at"`
+	ResolvedAt     *time.Time `json:"resolved_at"`
+	// Assignees is a comma-separated list of the names currently assigned
+	Assignees string `json:"assignees"`
+	// Watched marks incidents the user pinned. It is only changed through
+	// SetIncidentWatched, so polling upserts never reset it.
+	Watched bool `json:"watched"`
+	// AckedBy is who acknowledged the incident from this app, recorded before
+	// the API confirms so the UI can attribute it immediately. Unlike
+	// AcknowledgedBy it is never set by polling, and it is cleared on resolve.
+	AckedBy string `json:"acked_by"`
 	// AssignedToMe is a transient, read-time flag (not persisted). It marks
 	// incidents currently assigned to the logged-in user so the UI can offer an
 	// "Assigned" filter that spans services, including unconfigured ones.
@@ -50,6 +70,7 @@ type SidebarAlert struct {
 // SidebarNote represents note data stored in database  // SidebarNote represents note data stored in database  
 type SidebarNote struct {
 	ID              string `json:"id"`
+	IncidentID      string `json:"incident_id,omitempty"` // only set by SearchNotesByTag
 	Content         string `json:"content"`
 	CreatedAt       string `json:"created_at"`
 	UserName        string `json:"user_name,omitempty"`
@@ -59,6 +80,15 @@ type SidebarNote struct {
 	FreeformContent string `json:"freeform_content,omitempty"`
 }
 
+// SidebarLogEntry represents an incident log entry stored in database
+type SidebarLogEntry struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	Summary   string `json:"summary"`
+	CreatedAt string `json:"created_at"`
+	Agent     string `json:"agent,omitempty"`
+}
+
 // SidebarMetadata represents metadata for sidebar data
 type SidebarMetadata struct {
 	IncidentID        string
@@ -66,16 +96,25 @@ type SidebarMetadata struct {
 	LastFetchedNotes  *time.Time
 	LastAlertCount    int
 	LastUpdatedAt     *time.Time
+
+	LastFetchedLogEntries *time.Time
+
+	// Alert status breakdown from the last alerts fetch
+	TriggeredAlertCount int
+	ResolvedAlertCount  int
+
+	// Responders from the last successful responders fetch, as a JSON string
+	Responders string
 }
 
 // NewDB creates a new database connection - ORIGINAL METHOD UNCHANGED
 func NewDB(path string) (*DB, error) {
-	conn, err := sql.Open("sqlite3", path)
+	conn, err := sql.Open("sqlite3", sqliteDSN(path))
 	if err != nil {
 		return nil, err
 	}
 
-	db := &DB{conn: conn}
+	db := &DB{conn: conn, path: path}
 
 	// Create tables if they don't exist
 	if err := db.createTables(); err != nil {
@@ -89,11 +128,60 @@ func NewDB(path string) (*DB, error) {
 		return nil, err
 	}
 
+	// Create the retry queue for failed write operations
+	if err := db.createPendingActionsTable(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	// Bring databases created by older versions up to date
+	if err := db.runMigrations(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
 	return db, nil
 }
 
 
-// StoreIncidentAlerts stores alerts for an incident (links already JSON)
+// sqlitePragmas are applied by the driver to every pooled connection, which a
+// one-off PRAGMA statement after sql.Open would not do:
+//   - journal_mode=WAL lets readers proceed while polling writes
+//   - busy_timeout=5000 waits up to 5s for a lock instead of failing
+//   - synchronous=NORMAL is safe with WAL and avoids an fsync per write
+//   - foreign_keys=on makes ON DELETE CASCADE on the sidebar tables fire
+const sqlitePragmas = "_journal_mode=WAL&_busy_timeout=5000&_synchronous=NORMAL&_foreign_keys=on"
+
+// sqliteDSN appends sqlitePragmas to a database path
+func sqliteDSN(path string) string {
+	if strings.Contains(path, "?") {
+		return path + "&" + sqlitePragmas
+	}
+	return path + "?" + sqlitePragmas
+}
+
+// incidentQueryer is implemented by both *sql.DB and *sql.Tx
+type incidentQueryer interface {
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// incidentCached reports whether incidentID has a row in incidents. Sidebar
+// rows reference it with a foreign key, so the Store functions skip caching
+// for incidents that aren't stored, e.g. right after a resync cleared them.
+func incidentCached(q incidentQueryer, incidentID string) (bool, error) {
+	var exists int
+	err := q.QueryRow(`SELECT 1 FROM incidents WHERE incident_id = ?`, incidentID).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check incident %s: %w", incidentID, err)
+	}
+	return true, nil
+}
+
+// StoreIncidentAlerts stores alerts for an incident (links already JSON).
+// Nothing is stored for an incident missing from incidents.
 func (db *DB) StoreIncidentAlerts(incidentID string, alerts []SidebarAlert) error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
@@ -104,6 +192,10 @@ func (db *DB) StoreIncidentAlerts(incidentID string, alerts []SidebarAlert) erro
 	}
 	defer tx.Rollback()
 	
+	if cached, err := incidentCached(tx, incidentID); err != nil || !cached {
+		return err
+	}
+	
 	// Delete existing alerts for the incident
 	_, err = tx.Exec("DELETE FROM incident_alerts WHERE incident_id = ?", incidentID)
 	if err != nil {
@@ -184,6 +276,8 @@ func (db *DB) GetIncidentAlerts(incidentID string) ([]SidebarAlert, error) {
 	return alerts, nil
 }
 
+// StoreIncidentNotes replaces the cached notes for an incident. Nothing is
+// stored for an incident missing from incidents.
 func (db *DB) StoreIncidentNotes(incidentID string, notes []SidebarNote) error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
@@ -194,6 +288,10 @@ func (db *DB) StoreIncidentNotes(incidentID string, notes []SidebarNote) error {
 	}
 	defer tx.Rollback()
 	
+	if cached, err := incidentCached(tx, incidentID); err != nil || !cached {
+		return err
+	}
+	
 	// Delete existing notes for the incident
 	_, err = tx.Exec("DELETE FROM incident_notes WHERE incident_id = ?", incidentID)
 	if err != nil {
@@ -292,25 +390,204 @@ func (db *DB) GetIncidentNotes(incidentID string) ([]SidebarNote, error) {
 }
 
 
+// SearchNotesByTag returns notes with a tag selection matching tagName and
+// value, newest first. Matching is case-insensitive; an empty value matches
+// any selection under tagName.
+//
+// Tags are stored as a JSON array of {tag_name, selected_values}, so the match
+// is done with SQLite's JSON1 json_each. The JSON itself can't be indexed; the
+// partial idx_notes_tagged index keeps the scan to notes that have tags.
+func (db *DB) SearchNotesByTag(tagName, value string) ([]SidebarNote, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	query := `
+		SELECT n.id, n.incident_id, n.content, n.created_at, n.user_name,
+			   n.service_id, n.responses, n.tags, n.freeform_content
+		FROM incident_notes n
+		WHERE n.tags IS NOT NULL AND n.tags != '' AND json_valid(n.tags)
+		AND EXISTS (
+			SELECT 1
+			FROM json_each(n.tags) t, json_each(t.value, '$.selected_values') v
+			WHERE json_extract(t.value, '$.tag_name') = ? COLLATE NOCASE
+			AND (? = '' OR v.value = ? COLLATE NOCASE)
+		)
+		ORDER BY n.created_at DESC
+	`
+
+	rows, err := db.conn.Query(query, tagName, value, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search notes by tag: %w", err)
+	}
+	defer rows.Close()
+
+	notes := []SidebarNote{}
+	for rows.Next() {
+		var note SidebarNote
+		var userName, serviceID, responses, tags, freeformContent sql.NullString
+
+		err := rows.Scan(
+			&note.ID,
+			&note.IncidentID,
+			&note.Content,
+			&note.CreatedAt,
+			&userName,
+			&serviceID,
+			&responses,
+			&tags,
+			&freeformContent,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan note: %w", err)
+		}
+
+		note.UserName = userName.String
+		note.ServiceID = serviceID.String
+		note.Responses = responses.String
+		note.Tags = tags.String
+		note.FreeformContent = freeformContent.String
+
+		notes = append(notes, note)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating notes: %w", err)
+	}
+
+	return notes, nil
+}
+
+// StoreIncidentLogEntries replaces the cached log entries for an incident and
+// records the fetch time in the sidebar metadata. Nothing is stored for an
+// incident missing from incidents.
+func (db *DB) StoreIncidentLogEntries(incidentID string, entries []SidebarLogEntry) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if cached, err := incidentCached(tx, incidentID); err != nil || !cached {
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM incident_log_entries WHERE incident_id = ?", incidentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete existing log entries: %w", err)
+	}
+
+	stmt, err := tx.Prepare(`
+		INSERT OR REPLACE INTO incident_log_entries (id, incident_id, type, summary, created_at, agent)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare insert statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, entry := range entries {
+		_, err = stmt.Exec(entry.ID, incidentID, entry.Type, entry.Summary, entry.CreatedAt, entry.Agent)
+		if err != nil {
+			return fmt.Errorf("failed to insert log entry %s: %w", entry.ID, err)
+		}
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO incident_sidebar_metadata (incident_id, last_fetched_log_entries)
+		VALUES (?, ?)
+		ON CONFLICT(incident_id) DO UPDATE SET
+			last_fetched_log_entries = excluded.last_fetched_log_entries
+	`, incidentID, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to update log entries metadata: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// StoreSidebarResponders caches an incident's responders (a JSON string) in
+// the sidebar metadata. Nothing is stored for an incident missing from
+// incidents.
+func (db *DB) StoreSidebarResponders(incidentID, responders string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if cached, err := incidentCached(db.conn, incidentID); err != nil || !cached {
+		return err
+	}
+
+	_, err := db.conn.Exec(`
+		INSERT INTO incident_sidebar_metadata (incident_id, responders)
+		VALUES (?, ?)
+		ON CONFLICT(incident_id) DO UPDATE SET
+			responders = excluded.responders
+	`, incidentID, responders)
+	if err != nil {
+		return fmt.Errorf("failed to store responders: %w", err)
+	}
+
+	return nil
+}
+
+// GetIncidentLogEntries returns the cached log entries for an incident, oldest first
+func (db *DB) GetIncidentLogEntries(incidentID string) ([]SidebarLogEntry, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	rows, err := db.conn.Query(`
+		SELECT id, COALESCE(type, ''), COALESCE(summary, ''), COALESCE(created_at, ''), COALESCE(agent, '')
+		FROM incident_log_entries
+		WHERE incident_id = ?
+		ORDER BY created_at ASC
+	`, incidentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query log entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []SidebarLogEntry
+	for rows.Next() {
+		var entry SidebarLogEntry
+		if err := rows.Scan(&entry.ID, &entry.Type, &entry.Summary, &entry.CreatedAt, &entry.Agent); err != nil {
+			return nil, fmt.Errorf("failed to scan log entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
 // GetSidebarMetadata retrieves metadata for sidebar data
 func (db *DB) GetSidebarMetadata(incidentID string) (*SidebarMetadata, error) {
 	db.mu.RLock()
 	defer db.mu.RUnlock()
 	
 	query := `
-		SELECT last_fetched_alerts, last_fetched_notes, last_alert_count, last_updated_at
+		SELECT last_fetched_alerts, last_fetched_notes, last_alert_count, last_updated_at, last_fetched_log_entries,
+			COALESCE(triggered_alert_count, 0), COALESCE(resolved_alert_count, 0), COALESCE(responders, '')
 		FROM incident_sidebar_metadata
 		WHERE incident_id = ?
 	`
 	
 	var metadata SidebarMetadata
-	var lastFetchedAlerts, lastFetchedNotes, lastUpdatedAt sql.NullTime
+	var lastFetchedAlerts, lastFetchedNotes, lastUpdatedAt, lastFetchedLogEntries sql.NullTime
 	
 	err := db.conn.QueryRow(query, incidentID).Scan(
 		&lastFetchedAlerts,
 		&lastFetchedNotes,
 		&metadata.LastAlertCount,
 		&lastUpdatedAt,
+		&lastFetchedLogEntries,
+		&metadata.TriggeredAlertCount,
+		&metadata.ResolvedAlertCount,
+		&metadata.Responders,
 	)
 	
 	if err == sql.ErrNoRows {
@@ -331,19 +608,33 @@ func (db *DB) GetSidebarMetadata(incidentID string) (*SidebarMetadata, error) {
 	if lastUpdatedAt.Valid {
 		metadata.LastUpdatedAt = &lastUpdatedAt.Time
 	}
+	if lastFetchedLogEntries.Valid {
+		metadata.LastFetchedLogEntries = &lastFetchedLogEntries.Time
+	}
 	
 	return &metadata, nil
 }
 
+// UpdateSidebarMetadata records what was fetched for an incident's sidebar.
+// Nothing is stored for an incident missing from incidents.
 func (db *DB) UpdateSidebarMetadata(incidentID string, alertCount int, updatedAt time.Time, fetchedAlerts bool, fetchedNotes bool) error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 	
+	if cached, err := incidentCached(db.conn, incidentID); err != nil || !cached {
+		return err
+	}
+	
 	// Get current metadata to preserve unfetched timestamps
 	var existingAlertsFetch, existingNotesFetch sql.NullTime
+	var triggeredCount, resolvedCount int
 	
-	query := `SELECT last_fetched_alerts, last_fetched_notes FROM incident_sidebar_metadata WHERE incident_id = ?`
-	err := db.conn.QueryRow(query, incidentID).Scan(&existingAlertsFetch, &existingNotesFetch)
+	query := `
+		SELECT last_fetched_alerts, last_fetched_notes,
+			COALESCE(triggered_alert_count, 0), COALESCE(resolved_alert_count, 0)
+		FROM incident_sidebar_metadata WHERE incident_id = ?
+	`
+	err := db.conn.QueryRow(query, incidentID).Scan(&existingAlertsFetch, &existingNotesFetch, &triggeredCount, &resolvedCount)
 	
 	now := time.Now()
 	var alertsFetch, notesFetch sql.NullTime
@@ -371,18 +662,34 @@ func (db *DB) UpdateSidebarMetadata(incidentID string, alertCount int, updatedAt
 		return fmt.Errorf("failed to query existing metadata: %w", err)
 	}
 	
+	// Recount alert statuses from the freshly stored alerts
+	if fetchedAlerts {
+		countQuery := `
+			SELECT
+				COALESCE(SUM(CASE WHEN status = 'triggered' THEN 1 ELSE 0 END), 0),
+				COALESCE(SUM(CASE WHEN status = 'resolved' THEN 1 ELSE 0 END), 0)
+			FROM incident_alerts WHERE incident_id = ?
+		`
+		if err := db.conn.QueryRow(countQuery, incidentID).Scan(&triggeredCount, &resolvedCount); err != nil {
+			return fmt.Errorf("failed to count alert statuses: %w", err)
+		}
+	}
+	
 	// Upsert the metadata
 	upsertQuery := `
-		INSERT INTO incident_sidebar_metadata (incident_id, last_fetched_alerts, last_fetched_notes, last_alert_count, last_updated_at)
-		VALUES (?, ?, ?, ?, ?)
+		INSERT INTO incident_sidebar_metadata (incident_id, last_fetched_alerts, last_fetched_notes, last_alert_count, last_updated_at,
+			triggered_alert_count, resolved_alert_count)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(incident_id) DO UPDATE SET
 			last_fetched_alerts = excluded.last_fetched_alerts,
 			last_fetched_notes = excluded.last_fetched_notes,
 			last_alert_count = excluded.last_alert_count,
-			last_updated_at = excluded.last_updated_at
+			last_updated_at = excluded.last_updated_at,
+			triggered_alert_count = excluded.triggered_alert_count,
+			resolved_alert_count = excluded.resolved_alert_count
 	`
 	
-	_, err = db.conn.Exec(upsertQuery, incidentID, alertsFetch, notesFetch, alertCount, updatedAt)
+	_, err = db.conn.Exec(upsertQuery, incidentID, alertsFetch, notesFetch, alertCount, updatedAt, triggeredCount, resolvedCount)
 	if err != nil {
 		return fmt.Errorf("failed to upsert metadata: %w", err)
 	}
@@ -424,6 +731,18 @@ func (db *DB) CleanupOldSidebarData(cutoffDate time.Time) error {
 		return fmt.Errorf("failed to delete old notes: %w", err)
 	}
 	
+	// Delete log entries for old incidents
+	_, err = tx.Exec(`
+		DELETE FROM incident_log_entries
+		WHERE incident_id IN (
+			SELECT incident_id FROM incidents
+			WHERE updated_at < ?
+		)
+	`, cutoffDate)
+	if err != nil {
+		return fmt.Errorf("failed to delete old log entries: %w", err)
+	}
+	
 	// Delete metadata for old incidents
 	_, err = tx.Exec(`
 		DELETE FROM incident_sidebar_metadata
@@ -443,6 +762,40 @@ func (db *DB) CleanupOldSidebarData(cutoffDate time.Time) error {
 	return nil
 }
 
+// CleanupOrphanedSidebarData removes cached alerts, notes, log entries and
+// metadata whose incident no longer exists, e.g. after the startup wipe. It
+// returns the number of rows deleted.
+func (db *DB) CleanupOrphanedSidebarData() (int, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	total := 0
+	for _, table := range []string{"incident_alerts", "incident_notes", "incident_log_entries", "incident_sidebar_metadata"} {
+		result, err := tx.Exec(fmt.Sprintf(`
+			DELETE FROM %s
+			WHERE incident_id NOT IN (SELECT incident_id FROM incidents)
+		`, table))
+		if err != nil {
+			return 0, fmt.Errorf("failed to delete orphaned rows from %s: %w", table, err)
+		}
+		if n, err := result.RowsAffected(); err == nil {
+			total += int(n)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit cleanup transaction: %w", err)
+	}
+
+	return total, nil
+}
+
 
 // createTables - ORIGINAL METHOD ENHANCED WITH INDEXES
 func (db *DB) createTables() error {
@@ -461,6 +814,13 @@ func (db *DB) createTables() error {
 		alert_count INTEGER DEFAULT 0,
 		urgency TEXT DEFAULT 'low',
 		acknowledged_by TEXT DEFAULT '',
+		priority_id TEXT DEFAULT '',
+		priority_name TEXT DEFAULT '',
+		acknowledged_at DATETIME,
+		resolved_at DATETIME,
+		assignees TEXT DEFAULT '',
+		watched INTEGER DEFAULT 0,
+		acked_by TEXT DEFAULT '',
 		UNIQUE(incident_id)
 	);
 
@@ -474,11 +834,6 @@ func (db *DB) createTables() error {
 		return fmt.Errorf("failed to create incidents table: %w", err)
 	}
 
-	// Migrate existing databases: add the acknowledged_by column if it's missing.
-	if err := db.ensureColumn("incidents", "acknowledged_by", "TEXT DEFAULT ''"); err != nil {
-		return fmt.Errorf("failed to migrate incidents: %w", err)
-	}
-
 	return nil
 }
 
@@ -516,6 +871,21 @@ func (db *DB) createSidebarTables() error {
 	);
 	CREATE INDEX IF NOT EXISTS idx_notes_incident ON incident_notes(incident_id);
 	CREATE INDEX IF NOT EXISTS idx_notes_service ON incident_notes(service_id);
+	CREATE INDEX IF NOT EXISTS idx_notes_tagged ON incident_notes(created_at) WHERE tags IS NOT NULL AND tags != '';
+	`
+	
+	// Create incident_log_entries table
+	logEntriesTable := `
+	CREATE TABLE IF NOT EXISTS incident_log_entries (
+		id TEXT PRIMARY KEY,
+		incident_id TEXT NOT NULL,
+		type TEXT,
+		summary TEXT,
+		created_at TEXT,
+		agent TEXT,
+		FOREIGN KEY (incident_id) REFERENCES incidents(incident_id) ON DELETE CASCADE
+	);
+	CREATE INDEX IF NOT EXISTS idx_log_entries_incident ON incident_log_entries(incident_id);
 	`
 	
 	// Create incident_sidebar_metadata table
@@ -539,15 +909,14 @@ func (db *DB) createSidebarTables() error {
 		return fmt.Errorf("failed to create incident_notes table: %w", err)
 	}
 	
+	if _, err := db.conn.Exec(logEntriesTable); err != nil {
+		return fmt.Errorf("failed to create incident_log_entries table: %w", err)
+	}
+	
 	if _, err := db.conn.Exec(metadataTable); err != nil {
 		return fmt.Errorf("failed to create incident_sidebar_metadata table: %w", err)
 	}
 
-	// Migrate existing databases: add the description column if it's missing.
-	if err := db.ensureColumn("incident_alerts", "description", "TEXT"); err != nil {
-		return fmt.Errorf("failed to migrate incident_alerts: %w", err)
-	}
-
 	return nil
 }
 
@@ -623,7 +992,10 @@ func (db *DB) SetState(key, value string) error {
 			updated_at = CURRENT_TIMESTAMP
 	`
 
-	_, err := db.conn.Exec(query, key, value)
+	err := retryOnLock(func() error {
+		_, err := db.conn.Exec(query, key, value)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to set state %s: %w", key, err)
 	}
@@ -650,34 +1022,158 @@ func (db *DB) GetState(key string) (string, error) {
 	return value, nil
 }
 
+// SetStateInt stores an integer value in the state table
+func (db *DB) SetStateInt(key string, value int) error {
+	return db.SetState(key, strconv.Itoa(value))
+}
+
+// GetStateInt retrieves an integer value from the state table
+func (db *DB) GetStateInt(key string) (int, error) {
+	value, err := db.GetState(key)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer for state %s: %w", key, err)
+	}
+
+	return n, nil
+}
+
+// SetStateBool stores a boolean value in the state table
+func (db *DB) SetStateBool(key string, value bool) error {
+	return db.SetState(key, strconv.FormatBool(value))
+}
+
+// GetStateBool retrieves a boolean value from the state table
+func (db *DB) GetStateBool(key string) (bool, error) {
+	value, err := db.GetState(key)
+	if err != nil {
+		return false, err
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid boolean for state %s: %w", key, err)
+	}
+
+	return b, nil
+}
+
+// SetStateTime stores a timestamp in the state table as RFC3339
+func (db *DB) SetStateTime(key string, value time.Time) error {
+	return db.SetState(key, value.Format(time.RFC3339))
+}
+
+// GetStateTime retrieves an RFC3339 timestamp from the state table
+func (db *DB) GetStateTime(key string) (time.Time, error) {
+	value, err := db.GetState(key)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time for state %s: %w", key, err)
+	}
+
+	return t, nil
+}
+
+// upsertIncidentSQL inserts or updates an incident. The transition timestamps
+// are only filled in when still NULL, so the first observed time is kept
+// across polls instead of being overwritten.
+//
+// The update is skipped when the incoming updated_at is older than the stored
+// one, so the service and user polling loops can't flip-flop an incident
+// between two snapshots. julianday() normalizes timezone offsets. Sweeps that
+// resolve incidents locally must therefore leave updated_at alone, or a poll
+// that still reports the incident open would be ignored. resolved_at is
+// cleared when an incident comes back open.
+const upsertIncidentSQL = `
+	INSERT INTO incidents (
+		incident_id, incident_number, title, service_summary,
+		service_id, status, html_url, created_at, updated_at,
+		alert_count, urgency, acknowledged_by,
+		priority_id, priority_name,
+		acknowledged_at, resolved_at, assignees
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT(incident_id) DO UPDATE SET
+		incident_number = excluded.incident_number,
+		title = excluded.title,
+		service_summary = excluded.service_summary,
+		service_id = excluded.service_id,
+		status = excluded.status,
+		html_url = excluded.html_url,
+		created_at = excluded.created_at,
+		updated_at = excluded.updated_at,
+		alert_count = excluded.alert_count,
+		urgency = excluded.urgency,
+		acknowledged_by = excluded.acknowledged_by,
+		priority_id = excluded.priority_id,
+		priority_name = excluded.priority_name,
+		assignees = excluded.assignees,
+		acked_by = CASE WHEN excluded.status = 'resolved' THEN '' ELSE incidents.acked_by END,
+		acknowledged_at = COALESCE(incidents.acknowledged_at, excluded.acknowledged_at),
+		resolved_at = CASE WHEN excluded.status = 'resolved'
+			THEN COALESCE(incidents.resolved_at, excluded.resolved_at) END
+	WHERE julianday(incidents.updated_at) IS NULL
+		OR julianday(excluded.updated_at) >= julianday(incidents.updated_at)
+`
+
+// acknowledgedAtValue returns the acknowledged_at value to upsert: the known
+// time if set, otherwise updated_at when the incident is currently acknowledged.
+func (i IncidentData) acknowledgedAtValue() interface{} {
+	if i.AcknowledgedAt != nil {
+		return *i.AcknowledgedAt
+	}
+	if i.Status == "acknowledged" {
+		return i.UpdatedAt
+	}
+	return nil
+}
+
+// resolvedAtValue returns the resolved_at value to upsert, following the same
+// rules as acknowledgedAtValue.
+func (i IncidentData) resolvedAtValue() interface{} {
+	if i.ResolvedAt != nil {
+		return *i.ResolvedAt
+	}
+	if i.Status == "resolved" {
+		return i.UpdatedAt
+	}
+	return nil
+}
+
 // UpsertIncident - ENHANCED WITH THREAD SAFETY, SIGNATURE UNCHANGED
 func (db *DB) UpsertIncident(incident IncidentData) error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
-	// Use REPLACE for SQLite upsert pattern
-	query := `
-		REPLACE INTO incidents (
-			incident_id, incident_number, title, service_summary,
-			service_id, status, html_url, created_at, updated_at,
-			alert_count, urgency, acknowledged_by
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-	`
-
-	_, err := db.conn.Exec(query,
-		incident.IncidentID,
-		incident.IncidentNumber,
-		incident.Title,
-		incident.ServiceSummary,
-		incident.ServiceID,
-		incident.Status,
-		incident.HTMLURL,
-		incident.CreatedAt,
-		incident.UpdatedAt,
-		incident.AlertCount,
-		incident.Urgency,
-		incident.AcknowledgedBy,
-	)
+	err := retryOnLock(func() error {
+		_, err := db.conn.Exec(upsertIncidentSQL,
+			incident.IncidentID,
+			incident.IncidentNumber,
+			incident.Title,
+			incident.ServiceSummary,
+			incident.ServiceID,
+			incident.Status,
+			incident.HTMLURL,
+			incident.CreatedAt,
+			incident.UpdatedAt,
+			incident.AlertCount,
+			incident.Urgency,
+			incident.AcknowledgedBy,
+			incident.PriorityID,
+			incident.PriorityName,
+			incident.acknowledgedAtValue(),
+			incident.resolvedAtValue(),
+			incident.Assignees,
+		)
+		return err
+	})
 
 	if err != nil {
 		return fmt.Errorf("failed to upsert incident %s: %w", incident.IncidentID, err)
@@ -697,13 +1193,7 @@ func (db *DB) BatchUpsertIncidents(incidents []IncidentData) error {
 	}
 	defer tx.Rollback()
 
-	stmt, err := tx.Prepare(`
-		REPLACE INTO incidents (
-			incident_id, incident_number, title, service_summary,
-			service_id, status, html_url, created_at, updated_at,
-			alert_count, urgency, acknowledged_by
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-	`)
+	stmt, err := tx.Prepare(upsertIncidentSQL)
 	if err != nil {
 		return fmt.Errorf("failed to prepare statement: %w", err)
 	}
@@ -723,6 +1213,11 @@ func (db *DB) BatchUpsertIncidents(incidents []IncidentData) error {
 			incident.AlertCount,
 			incident.Urgency,
 			incident.AcknowledgedBy,
+			incident.PriorityID,
+			incident.PriorityName,
+			incident.acknowledgedAtValue(),
+			incident.resolvedAtValue(),
+			incident.Assignees,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to upsert incident %s: %w", incident.IncidentID, err)
@@ -736,18 +1231,209 @@ func (db *DB) BatchUpsertIncidents(incidents []IncidentData) error {
 	return nil
 }
 
+// incidentColumns is the column list selected by every incident query, in
+// the order scanIncident reads them
+const incidentColumns = `incident_id, incident_number, title, service_summary,
+			   service_id, status, html_url, created_at, updated_at, alert_count,
+			   COALESCE(urgency, 'low') as urgency,
+			   COALESCE(acknowledged_by, '') as acknowledged_by,
+			   COALESCE(priority_id, '') as priority_id,
+			   COALESCE(priority_name, '') as priority_name,
+			   acknowledged_at,
+			   resolved_at,
+			   COALESCE(assignees, '') as assignees,
+			   COALESCE(watched, 0) as watched,
+			   COALESCE(acked_by, '') as acked_by`
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanIncident reads one row selected with incidentColumns
+func scanIncident(row rowScanner) (IncidentData, error) {
+	var i IncidentData
+	err := row.Scan(
+		&i.IncidentID,
+		&i.IncidentNumber,
+		&i.Title,
+		&i.ServiceSummary,
+		&i.ServiceID,
+		&i.Status,
+		&i.HTMLURL,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AlertCount,
+		&i.Urgency,
+		&i.AcknowledgedBy,
+		&i.PriorityID,
+		&i.PriorityName,
+		&i.AcknowledgedAt,
+		&i.ResolvedAt,
+		&i.Assignees,
+		&i.Watched,
+		&i.AckedBy,
+	)
+	return i, err
+}
+
 // GetOpenIncidents - ENHANCED WITH THREAD SAFETY AND ORDERING, SIGNATURE UNCHANGED
 func (db *DB) GetOpenIncidents() ([]IncidentData, error) {
-	db.mu.RLock()
-	defer db.mu.RUnlock()
+	return db.GetOpenIncidentsSorted("status")
+}
 
-	query := `
-		SELECT incident_id, incident_number, title, service_summary,
-			   service_id, status, html_url, created_at, updated_at, alert_count,
-			   COALESCE(urgency, 'low') as urgency,
-			   COALESCE(acknowledged_by, '') as acknowledged_by
+// openIncidentOrders maps the sort modes accepted by GetOpenIncidentsSorted to
+// their ORDER BY clauses. Every mode falls back to newest first.
+var openIncidentOrders = map[string]string{
+	"status": `
+			CASE status
+				WHEN 'triggered' THEN 1
+				WHEN 'acknowledged' THEN 2
+			END,
+			created_at DESC`,
+	"urgency": `
+			CASE COALESCE(urgency, 'low') WHEN 'high' THEN 1 ELSE 2 END,
+			created_at DESC`,
+	"created": `
+			created_at DESC`,
+	"alerts": `
+			alert_count DESC,
+			created_at DESC`,
+}
+
+// GetOpenIncidentsSorted returns open incidents ordered by sortBy: "status"
+// (triggered first), "urgency" (high first), "created" (newest first) or
+// "alerts" (most alerts first)
+func (db *DB) GetOpenIncidentsSorted(sortBy string) ([]IncidentData, error) {
+	orderBy, ok := openIncidentOrders[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sort mode: %s", sortBy)
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	query := `
+		SELECT ` + incidentColumns + `
+		FROM incidents
+		WHERE status IN ('triggered', 'acknowledged')
+		ORDER BY` + orderBy
+
+	rows, err := db.conn.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query open incidents: %w", err)
+	}
+	defer rows.Close()
+
+	var incidents []IncidentData
+	for rows.Next() {
+		i, err := scanIncident(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan incident: %w", err)
+		}
+		incidents = append(incidents, i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return incidents, nil
+}
+
+// GetOpenIncidentCountsByService returns the number of open incidents keyed by
+// service ID. Services without open incidents are omitted.
+func (db *DB) GetOpenIncidentCountsByService() (map[string]int, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	rows, err := db.conn.Query(`
+		SELECT service_id, COUNT(*)
+		FROM incidents
+		WHERE status IN ('triggered', 'acknowledged')
+		GROUP BY service_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count open incidents: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var serviceID string
+		var count int
+		if err := rows.Scan(&serviceID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan incident count: %w", err)
+		}
+		counts[serviceID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetNoteCountsByIncident returns the number of cached notes keyed by incident
+// ID. Incidents without cached notes are omitted. Notes are only cached once
+// an incident's sidebar has been opened, so the count can lag the API.
+func (db *DB) GetNoteCountsByIncident(incidentIDs []string) (map[string]int, error) {
+	counts := make(map[string]int)
+	if len(incidentIDs) == 0 {
+		return counts, nil
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	placeholders := make([]string, len(incidentIDs))
+	args := make([]interface{}, len(incidentIDs))
+	for i, id := range incidentIDs {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+
+	query := fmt.Sprintf(`
+		SELECT incident_id, COUNT(*)
+		FROM incident_notes
+		WHERE incident_id IN (%s)
+		GROUP BY incident_id
+	`, strings.Join(placeholders, ","))
+
+	rows, err := db.conn.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count notes: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var incidentID string
+		var count int
+		if err := rows.Scan(&incidentID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan note count: %w", err)
+		}
+		counts[incidentID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetOpenIncidentsByUrgency returns open incidents with the given urgency, in
+// the same order as GetOpenIncidents
+func (db *DB) GetOpenIncidentsByUrgency(urgency string) ([]IncidentData, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	query := `
+		SELECT ` + incidentColumns + `
 		FROM incidents
 		WHERE status IN ('triggered', 'acknowledged')
+			AND COALESCE(urgency, 'low') = ?
 		ORDER BY 
 			CASE status 
 				WHEN 'triggered' THEN 1 
@@ -756,29 +1442,15 @@ func (db *DB) GetOpenIncidents() ([]IncidentData, error) {
 			created_at DESC
 	`
 
-	rows, err := db.conn.Query(query)
+	rows, err := db.conn.Query(query, urgency)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query open incidents: %w", err)
+		return nil, fmt.Errorf("failed to query open incidents by urgency: %w", err)
 	}
 	defer rows.Close()
 
 	var incidents []IncidentData
 	for rows.Next() {
-		var i IncidentData
-		err := rows.Scan(
-			&i.IncidentID,
-			&i.IncidentNumber,
-			&i.Title,
-			&i.ServiceSummary,
-			&i.ServiceID,
-			&i.Status,
-			&i.HTMLURL,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.AlertCount,
-			&i.Urgency,
-			&i.AcknowledgedBy,
-		)
+		i, err := scanIncident(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan incident: %w", err)
 		}
@@ -792,56 +1464,74 @@ func (db *DB) GetOpenIncidents() ([]IncidentData, error) {
 	return incidents, nil
 }
 
-// GetResolvedIncidents - ENHANCED WITH THREAD SAFETY, SIGNATURE UNCHANGED
-func (db *DB) GetResolvedIncidents() ([]IncidentData, error) {
+// GetResolvedIncidents returns up to limit of the most recently resolved
+// incidents. A non-positive limit uses the default page size.
+func (db *DB) GetResolvedIncidents(limit int) ([]IncidentData, error) {
+	incidents, _, err := db.GetResolvedIncidentsPaged(nil, limit, 0)
+	return incidents, err
+}
+
+// defaultResolvedPageSize is the page size used by the unpaged resolved queries
+const defaultResolvedPageSize = 100
+
+// GetResolvedIncidentsPaged returns one page of resolved incidents, most
+// recently updated first, along with the total number of matches. An empty
+// serviceIDs matches every service.
+func (db *DB) GetResolvedIncidentsPaged(serviceIDs []string, limit, offset int) ([]IncidentData, int, error) {
+	if limit <= 0 {
+		limit = defaultResolvedPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
 	db.mu.RLock()
 	defer db.mu.RUnlock()
 
-	query := `
-		SELECT incident_id, incident_number, title, service_summary,
-			   service_id, status, html_url, created_at, updated_at, alert_count,
-			   COALESCE(urgency, 'low') as urgency,
-			   COALESCE(acknowledged_by, '') as acknowledged_by
+	where := "status = 'resolved'"
+	var args []interface{}
+	if len(serviceIDs) > 0 {
+		placeholders := make([]string, len(serviceIDs))
+		for i, id := range serviceIDs {
+			args = append(args, id)
+			placeholders[i] = "?"
+		}
+		where += fmt.Sprintf(" AND service_id IN (%s)", strings.Join(placeholders, ","))
+	}
+
+	var total int
+	if err := db.conn.QueryRow("SELECT COUNT(*) FROM incidents WHERE "+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count resolved incidents: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT ` + incidentColumns + `
 		FROM incidents
-		WHERE status = 'resolved'
+		WHERE %s
 		ORDER BY updated_at DESC
-		LIMIT 100
-	`
+		LIMIT ? OFFSET ?
+	`, where)
 
-	rows, err := db.conn.Query(query)
+	rows, err := db.conn.Query(query, append(args, limit, offset)...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query resolved incidents: %w", err)
+		return nil, 0, fmt.Errorf("failed to query resolved incidents: %w", err)
 	}
 	defer rows.Close()
 
 	var incidents []IncidentData
 	for rows.Next() {
-		var i IncidentData
-		err := rows.Scan(
-			&i.IncidentID,
-			&i.IncidentNumber,
-			&i.Title,
-			&i.ServiceSummary,
-			&i.ServiceID,
-			&i.Status,
-			&i.HTMLURL,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.AlertCount,
-			&i.Urgency,
-			&i.AcknowledgedBy,
-		)
+		i, err := scanIncident(rows)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan incident: %w", err)
+			return nil, 0, fmt.Errorf("failed to scan incident: %w", err)
 		}
 		incidents = append(incidents, i)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating rows: %w", err)
+		return nil, 0, fmt.Errorf("error iterating rows: %w", err)
 	}
 
-	return incidents, nil
+	return incidents, total, nil
 }
 
 // ClearIncidents - ENHANCED WITH THREAD SAFETY, SIGNATURE UNCHANGED
@@ -858,57 +1548,327 @@ func (db *DB) ClearIncidents() error {
 	return nil
 }
 
-// GetResolvedIncidentsByServices - ENHANCED WITH THREAD SAFETY, SIGNATURE UNCHANGED
-func (db *DB) GetResolvedIncidentsByServices(serviceIDs []string) ([]IncidentData, error) {
+// SetIncidentStatus updates only an incident's status, leaving the transition
+// timestamps alone so the change can be reverted cleanly. It returns the
+// previous status.
+func (db *DB) SetIncidentStatus(incidentID, status string) (string, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var previous string
+	err = tx.QueryRow(`SELECT status FROM incidents WHERE incident_id = ?`, incidentID).Scan(&previous)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("incident not found: %s", incidentID)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get incident status: %w", err)
+	}
+
+	if _, err := tx.Exec(`UPDATE incidents SET status = ? WHERE incident_id = ?`, status, incidentID); err != nil {
+		return "", fmt.Errorf("failed to update incident status: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return previous, nil
+}
+
+// SetIncidentAckedBy records who acknowledged an incident from this app and
+// when. Passing the previous values reverts a failed acknowledgement.
+func (db *DB) SetIncidentAckedBy(incidentID, ackedBy string, acknowledgedAt *time.Time) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	result, err := db.conn.Exec(
+		`UPDATE incidents SET acked_by = ?, acknowledged_at = ? WHERE incident_id = ?`,
+		ackedBy, acknowledgedAt, incidentID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set acked_by for incident %s: %w", incidentID, err)
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf("incident not found: %s", incidentID)
+	}
+
+	return nil
+}
+
+// ReopenIncident marks a resolved incident triggered again, clearing the
+// acknowledgement and resolution times so they are recorded afresh
+func (db *DB) ReopenIncident(incidentID string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	query := `
+		UPDATE incidents
+		SET status = 'triggered', acknowledged_at = NULL, resolved_at = NULL,
+			acknowledged_by = '', acked_by = ''
+		WHERE incident_id = ?
+	`
+
+	result, err := db.conn.Exec(query, incidentID)
+	if err != nil {
+		return fmt.Errorf("failed to reopen incident %s: %w", incidentID, err)
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf("incident not found: %s", incidentID)
+	}
+
+	return nil
+}
+
+// ClearUnwatchedIncidents removes every incident except watched ones, so the
+// watch list survives the startup wipe. The next poll refreshes their data.
+func (db *DB) ClearUnwatchedIncidents() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	query := `DELETE FROM incidents WHERE COALESCE(watched, 0) = 0`
+	_, err := db.conn.Exec(query)
+	if err != nil {
+		return fmt.Errorf("failed to clear unwatched incidents: %w", err)
+	}
+
+	return nil
+}
+
+// SetIncidentWatched pins or unpins an incident
+func (db *DB) SetIncidentWatched(incidentID string, watched bool) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	result, err := db.conn.Exec(`UPDATE incidents SET watched = ? WHERE incident_id = ?`, watched, incidentID)
+	if err != nil {
+		return fmt.Errorf("failed to update watched flag: %w", err)
+	}
+
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return fmt.Errorf("incident not found: %s", incidentID)
+	}
+
+	return nil
+}
+
+// GetWatchedIncidents returns all watched incidents, newest first
+func (db *DB) GetWatchedIncidents() ([]IncidentData, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	query := `
+		SELECT ` + incidentColumns + `
+		FROM incidents
+		WHERE watched = 1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := db.conn.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query watched incidents: %w", err)
+	}
+	defer rows.Close()
+
+	var incidents []IncidentData
+	for rows.Next() {
+		i, err := scanIncident(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan incident: %w", err)
+		}
+		incidents = append(incidents, i)
+	}
+
+	return incidents, rows.Err()
+}
+
+// GetResolvedIncidentsByServices returns up to limit of the most recently
+// resolved incidents for the given services. A non-positive limit uses the
+// default page size.
+func (db *DB) GetResolvedIncidentsByServices(serviceIDs []string, limit int) ([]IncidentData, error) {
 	if len(serviceIDs) == 0 {
 		return []IncidentData{}, nil
 	}
 
+	incidents, _, err := db.GetResolvedIncidentsPaged(serviceIDs, limit, 0)
+	return incidents, err
+}
+
+// GetResolvedIncidentsByServicesAndRange returns up to limit incidents for the
+// given services that were resolved between since and until (inclusive), most
+// recent first. resolved_at is used when recorded, otherwise updated_at.
+func (db *DB) GetResolvedIncidentsByServicesAndRange(serviceIDs []string, since, until time.Time, limit int) ([]IncidentData, error) {
+	if len(serviceIDs) == 0 {
+		return []IncidentData{}, nil
+	}
+	if limit <= 0 {
+		limit = defaultResolvedPageSize
+	}
+
 	db.mu.RLock()
 	defer db.mu.RUnlock()
 
-	// Build parameterized query with proper escaping
-	args := make([]interface{}, len(serviceIDs))
 	placeholders := make([]string, len(serviceIDs))
+	args := make([]interface{}, 0, len(serviceIDs)+3)
 	for i, id := range serviceIDs {
-		args[i] = id
 		placeholders[i] = "?"
+		args = append(args, id)
 	}
+	args = append(args, since, until, limit)
 
 	query := fmt.Sprintf(`
-		SELECT incident_id, incident_number, title, service_summary,
-			   service_id, status, html_url, created_at, updated_at, alert_count,
-			   COALESCE(urgency, 'low') as urgency,
-			   COALESCE(acknowledged_by, '') as acknowledged_by
+		SELECT ` + incidentColumns + `
 		FROM incidents
-		WHERE status = 'resolved' AND service_id IN (%s)
-		ORDER BY updated_at DESC
-		LIMIT 100
+		WHERE status = 'resolved'
+		AND service_id IN (%s)
+		AND julianday(COALESCE(resolved_at, updated_at)) BETWEEN julianday(?) AND julianday(?)
+		ORDER BY COALESCE(resolved_at, updated_at) DESC
+		LIMIT ?
 	`, strings.Join(placeholders, ","))
 
 	rows, err := db.conn.Query(query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query resolved incidents by services: %w", err)
+		return nil, fmt.Errorf("failed to query resolved incidents in range: %w", err)
 	}
 	defer rows.Close()
 
-	var incidents []IncidentData
+	incidents := []IncidentData{}
 	for rows.Next() {
-		var i IncidentData
-		err := rows.Scan(
-			&i.IncidentID,
-			&i.IncidentNumber,
-			&i.Title,
-			&i.ServiceSummary,
-			&i.ServiceID,
-			&i.Status,
-			&i.HTMLURL,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.AlertCount,
-			&i.Urgency,
-			&i.AcknowledgedBy,
-		)
+		i, err := scanIncident(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan incident: %w", err)
+		}
+		incidents = append(incidents, i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return incidents, nil
+}
+
+// GetIncidentsInRange returns all incidents created between since and until (inclusive),
+// oldest first. Used for exports.
+func (db *DB) GetIncidentsInRange(since, until time.Time) ([]IncidentData, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	query := `
+		SELECT ` + incidentColumns + `
+		FROM incidents
+		WHERE created_at >= ? AND created_at <= ?
+		ORDER BY created_at ASC
+	`
+
+	rows, err := db.conn.Query(query, since, until)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query incidents in range: %w", err)
+	}
+	defer rows.Close()
+
+	incidents := []IncidentData{}
+	for rows.Next() {
+		i, err := scanIncident(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan incident: %w", err)
+		}
+		incidents = append(incidents, i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return incidents, nil
+}
+
+// GetIncidentsByServices returns incidents for the given services, newest
+// first. An empty statuses list matches every status, and a non-positive limit
+// returns all matches.
+func (db *DB) GetIncidentsByServices(serviceIDs []string, statuses []string, limit int) ([]IncidentData, error) {
+	if len(serviceIDs) == 0 {
+		return []IncidentData{}, nil
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	placeholders := make([]string, len(serviceIDs))
+	args := make([]interface{}, 0, len(serviceIDs)+len(statuses)+1)
+	for i, id := range serviceIDs {
+		placeholders[i] = "?"
+		args = append(args, id)
+	}
+	where := fmt.Sprintf("service_id IN (%s)", strings.Join(placeholders, ","))
+
+	if len(statuses) > 0 {
+		statusPlaceholders := make([]string, len(statuses))
+		for i, status := range statuses {
+			statusPlaceholders[i] = "?"
+			args = append(args, status)
+		}
+		where += fmt.Sprintf(" AND status IN (%s)", strings.Join(statusPlaceholders, ","))
+	}
+
+	query := `
+		SELECT ` + incidentColumns + `
+		FROM incidents
+		WHERE ` + where + `
+		ORDER BY created_at DESC`
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := db.conn.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query incidents by services: %w", err)
+	}
+	defer rows.Close()
+
+	incidents := []IncidentData{}
+	for rows.Next() {
+		i, err := scanIncident(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan incident: %w", err)
+		}
+		incidents = append(incidents, i)
+	}
+
+	return incidents, rows.Err()
+}
+
+// GetIncidentsUpdatedSince returns incidents with updated_at at or after since,
+// oldest change first, so callers can apply only the rows that changed. The
+// range filter and ordering are both served by idx_incidents_updated.
+func (db *DB) GetIncidentsUpdatedSince(since time.Time) ([]IncidentData, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	query := `
+		SELECT ` + incidentColumns + `
+		FROM incidents
+		WHERE updated_at >= ?
+		ORDER BY updated_at ASC
+	`
+
+	rows, err := db.conn.Query(query, since.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query updated incidents: %w", err)
+	}
+	defer rows.Close()
+
+	incidents := []IncidentData{}
+	for rows.Next() {
+		i, err := scanIncident(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan incident: %w", err)
 		}
@@ -930,14 +1890,15 @@ func (db *DB) GetIncidentStats() (map[string]interface{}, error) {
 	stats := make(map[string]interface{})
 
 	// Count by status
-	var triggered, acknowledged, resolved int
+	var triggered, acknowledged, resolved, highUrgencyTriggered int
 	err := db.conn.QueryRow(`
 		SELECT 
 			COUNT(CASE WHEN status = 'triggered' THEN 1 END) as triggered,
 			COUNT(CASE WHEN status = 'acknowledged' THEN 1 END) as acknowledged,
-			COUNT(CASE WHEN status = 'resolved' THEN 1 END) as resolved
+			COUNT(CASE WHEN status = 'resolved' THEN 1 END) as resolved,
+			COUNT(CASE WHEN status = 'triggered' AND urgency = 'high' THEN 1 END) as high_urgency_triggered
 		FROM incidents
-	`).Scan(&triggered, &acknowledged, &resolved)
+	`).Scan(&triggered, &acknowledged, &resolved, &highUrgencyTriggered)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get incident stats: %w", err)
@@ -947,9 +1908,140 @@ func (db *DB) GetIncidentStats() (map[string]interface{}, error) {
 	stats["acknowledged"] = acknowledged
 	stats["resolved"] = resolved
 	stats["total"] = triggered + acknowledged + resolved
+	stats["high_urgency_triggered"] = highUrgencyTriggered
 
 	return stats, nil
 }
+
+// diagnosticTables are the tables whose row counts GetTableRowCounts reports
+var diagnosticTables = []string{
+	"incidents",
+	"incident_alerts",
+	"incident_notes",
+	"incident_sidebar_metadata",
+	"app_state",
+}
+
+// GetTableRowCounts returns the number of rows in each of the main tables,
+// keyed by table name
+func (db *DB) GetTableRowCounts() (map[string]int, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	counts := make(map[string]int, len(diagnosticTables))
+	for _, table := range diagnosticTables {
+		var count int
+		// Table names come from the fixed list above, never from input
+		if err := db.conn.QueryRow(`SELECT COUNT(*) FROM ` + table).Scan(&count); err != nil {
+			return nil, fmt.Errorf("failed to count rows in %s: %w", table, err)
+		}
+		counts[table] = count
+	}
+
+	return counts, nil
+}
+
+// GetIncidentTimeRange returns the oldest and newest incident created_at, or
+// nil when there are no incidents
+func (db *DB) GetIncidentTimeRange() (oldest, newest *time.Time, err error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	// ORDER BY ... LIMIT 1 rather than MIN/MAX so the driver still sees a
+	// DATETIME column and parses the value
+	var first, last sql.NullTime
+	err = db.conn.QueryRow(`SELECT created_at FROM incidents ORDER BY created_at ASC LIMIT 1`).Scan(&first)
+	if err == sql.ErrNoRows {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get oldest incident: %w", err)
+	}
+	if err := db.conn.QueryRow(`SELECT created_at FROM incidents ORDER BY created_at DESC LIMIT 1`).Scan(&last); err != nil {
+		return nil, nil, fmt.Errorf("failed to get newest incident: %w", err)
+	}
+
+	if first.Valid {
+		oldest = &first.Time
+	}
+	if last.Valid {
+		newest = &last.Time
+	}
+	return oldest, newest, nil
+}
+
+// GetIncidentMetrics computes mean time to acknowledge (MTTA) and mean time to
+// resolve (MTTR) for incidents created since the given time.
+//
+// acknowledged_at and resolved_at are used when recorded. Incidents stored
+// before those columns existed fall back to updated_at, which is only an
+// approximation: later updates (new alerts, reassignment) push it forward.
+func (db *DB) GetIncidentMetrics(since time.Time) (map[string]interface{}, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	rows, err := db.conn.Query(`
+		SELECT status, created_at, updated_at, acknowledged_at, resolved_at
+		FROM incidents
+		WHERE created_at >= ?
+		AND (status IN ('acknowledged', 'resolved') OR acknowledged_at IS NOT NULL)
+	`, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query incident metrics: %w", err)
+	}
+	defer rows.Close()
+
+	var ackTotal, resolveTotal time.Duration
+	var ackCount, resolveCount int
+	for rows.Next() {
+		var status string
+		var createdAt, updatedAt time.Time
+		var acknowledgedAt, resolvedAt *time.Time
+		if err := rows.Scan(&status, &createdAt, &updatedAt, &acknowledgedAt, &resolvedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan incident metrics: %w", err)
+		}
+
+		if acknowledgedAt == nil && status == "acknowledged" {
+			acknowledgedAt = &updatedAt
+		}
+		if resolvedAt == nil && status == "resolved" {
+			resolvedAt = &updatedAt
+		}
+
+		if acknowledgedAt != nil {
+			if elapsed := acknowledgedAt.Sub(createdAt); elapsed >= 0 {
+				ackTotal += elapsed
+				ackCount++
+			}
+		}
+		if resolvedAt != nil {
+			if elapsed := resolvedAt.Sub(createdAt); elapsed >= 0 {
+				resolveTotal += elapsed
+				resolveCount++
+			}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	metrics := make(map[string]interface{})
+	metrics["since"] = since
+	metrics["acknowledged_count"] = ackCount
+	metrics["resolved_count"] = resolveCount
+	metrics["mtta_seconds"] = 0.0
+	metrics["mttr_seconds"] = 0.0
+	if ackCount > 0 {
+		metrics["mtta_seconds"] = (ackTotal / time.Duration(ackCount)).Seconds()
+	}
+	if resolveCount > 0 {
+		metrics["mttr_seconds"] = (resolveTotal / time.Duration(resolveCount)).Seconds()
+	}
+
+	return metrics, nil
+}
+
 func (db *DB) GetNewestResolvedIncidentDate() (time.Time, error) {
 	db.mu.RLock()
 	defer db.mu.RUnlock()
@@ -982,7 +2074,8 @@ func (db *DB) RemoveStaleOpenIncidents(currentIncidentIDs []string, serviceIDs [
 		// If no incidents returned from API but we have services, remove all open incidents for those services
 		query := `
 			UPDATE incidents 
-			SET status = 'resolved', updated_at = CURRENT_TIMESTAMP
+			SET status = 'resolved', acked_by = '',
+				resolved_at = COALESCE(resolved_at, CURRENT_TIMESTAMP)
 			WHERE status IN ('triggered', 'acknowledged')
 		`
 
@@ -1014,7 +2107,8 @@ func (db *DB) RemoveStaleOpenIncidents(currentIncidentIDs []string, serviceIDs [
 
 	query := fmt.Sprintf(`
 		UPDATE incidents 
-		SET status = 'resolved', updated_at = CURRENT_TIMESTAMP
+		SET status = 'resolved', acked_by = '',
+			resolved_at = COALESCE(resolved_at, CURRENT_TIMESTAMP)
 		WHERE status IN ('triggered', 'acknowledged')
 		AND incident_id NOT IN (%s)
 	`, strings.Join(placeholders, ","))
@@ -1041,6 +2135,15 @@ func (db *DB) UpdateIncidentsBatch(incidents []IncidentData, staleIDs []string)
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
+	// The whole transaction is retried: a locked commit rolls everything back
+	return retryOnLock(func() error {
+		return db.updateIncidentsBatchTx(incidents, staleIDs)
+	})
+}
+
+// updateIncidentsBatchTx upserts incidents and resolves staleIDs in one
+// transaction. Callers must hold db.mu.
+func (db *DB) updateIncidentsBatchTx(incidents []IncidentData, staleIDs []string) error {
 	tx, err := db.conn.Begin()
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -1048,13 +2151,7 @@ func (db *DB) UpdateIncidentsBatch(incidents []IncidentData, staleIDs []string)
 	defer tx.Rollback()
 
 	// Prepare upsert statement
-	upsertStmt, err := tx.Prepare(`
-		REPLACE INTO incidents (
-			incident_id, incident_number, title, service_summary,
-			service_id, status, html_url, created_at, updated_at,
-			alert_count, urgency, acknowledged_by
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-	`)
+	upsertStmt, err := tx.Prepare(upsertIncidentSQL)
 	if err != nil {
 		return fmt.Errorf("failed to prepare upsert statement: %w", err)
 	}
@@ -1075,6 +2172,11 @@ func (db *DB) UpdateIncidentsBatch(incidents []IncidentData, staleIDs []string)
 			incident.AlertCount,
 			incident.Urgency,
 			incident.AcknowledgedBy,
+			incident.PriorityID,
+			incident.PriorityName,
+			incident.acknowledgedAtValue(),
+			incident.resolvedAtValue(),
+			incident.Assignees,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to upsert incident %s: %w", incident.IncidentID, err)
@@ -1092,7 +2194,8 @@ func (db *DB) UpdateIncidentsBatch(incidents []IncidentData, staleIDs []string)
 
 		query := fmt.Sprintf(`
 			UPDATE incidents 
-			SET status = 'resolved', updated_at = CURRENT_TIMESTAMP
+			SET status = 'resolved', acked_by = '',
+				resolved_at = COALESCE(resolved_at, CURRENT_TIMESTAMP)
 			WHERE incident_id IN (%s)
 		`, strings.Join(placeholders, ","))
 
@@ -1115,30 +2218,12 @@ func (db *DB) GetIncidentByID(incidentID string) (IncidentData, error) {
 	defer db.mu.RUnlock()
 
 	query := `
-		SELECT incident_id, incident_number, title, service_summary,
-			   service_id, status, html_url, created_at, updated_at, alert_count,
-			   COALESCE(urgency, 'low') as urgency,
-			   COALESCE(acknowledged_by, '') as acknowledged_by
+		SELECT ` + incidentColumns + `
 		FROM incidents
 		WHERE incident_id = ?
 	`
 
-	var incident IncidentData
-	err := db.conn.QueryRow(query, incidentID).Scan(
-		&incident.IncidentID,
-		&incident.IncidentNumber,
-		&incident.Title,
-		&incident.ServiceSummary,
-		&incident.ServiceID,
-		&incident.Status,
-		&incident.HTMLURL,
-		&incident.CreatedAt,
-		&incident.UpdatedAt,
-		&incident.AlertCount,
-		&incident.Urgency,
-		&incident.AcknowledgedBy,
-	)
-
+	incident, err := scanIncident(db.conn.QueryRow(query, incidentID))
 	if err == sql.ErrNoRows {
 		return incident, fmt.Errorf("incident not found: %s", incidentID)
 	}
@@ -1150,7 +2235,7 @@ func (db *DB) GetIncidentByID(incidentID string) (IncidentData, error) {
 	return incident, nil
 }
 
-// ClearIncidentSidebarCache removes cached alerts and notes for an incident
+// ClearIncidentSidebarCache removes cached alerts, notes and log entries for an incident
 func (db *DB) ClearIncidentSidebarCache(incidentID string) error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
@@ -1173,6 +2258,12 @@ func (db *DB) ClearIncidentSidebarCache(incidentID string) error {
 		return fmt.Errorf("failed to delete notes: %w", err)
 	}
 
+	// Delete log entries for this incident
+	_, err = tx.Exec("DELETE FROM incident_log_entries WHERE incident_id = ?", incidentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete log entries: %w", err)
+	}
+
 	// Delete metadata for this incident
 	_, err = tx.Exec("DELETE FROM incident_sidebar_metadata WHERE incident_id = ?", incidentID)
 	if err != nil {
@@ -1186,8 +2277,9 @@ func (db *DB) ClearIncidentSidebarCache(incidentID string) error {
 	return nil
 }
 
-// CleanupOldIncidents deletes incidents and all related data older than the cutoff date
-func (db *DB) CleanupOldIncidents(cutoffDate time.Time) error {
+// ClearIncidentNotesCache removes cached notes for an incident and marks them
+// as never fetched, leaving alerts and log entries in place
+func (db *DB) ClearIncidentNotesCache(incidentID string) error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
@@ -1197,59 +2289,101 @@ func (db *DB) CleanupOldIncidents(cutoffDate time.Time) error {
 	}
 	defer tx.Rollback()
 
-	// Delete alerts for old incidents
-	_, err = tx.Exec(`
-		DELETE FROM incident_alerts
-		WHERE incident_id IN (
-			SELECT incident_id FROM incidents
-			WHERE updated_at < ?
-		)
-	`, cutoffDate)
+	_, err = tx.Exec("DELETE FROM incident_notes WHERE incident_id = ?", incidentID)
 	if err != nil {
-		return fmt.Errorf("failed to delete old alerts: %w", err)
+		return fmt.Errorf("failed to delete notes: %w", err)
 	}
 
-	// Delete notes for old incidents
-	_, err = tx.Exec(`
-		DELETE FROM incident_notes
-		WHERE incident_id IN (
-			SELECT incident_id FROM incidents
-			WHERE updated_at < ?
-		)
-	`, cutoffDate)
+	_, err = tx.Exec("UPDATE incident_sidebar_metadata SET last_fetched_notes = NULL WHERE incident_id = ?", incidentID)
 	if err != nil {
-		return fmt.Errorf("failed to delete old notes: %w", err)
+		return fmt.Errorf("failed to reset notes fetch time: %w", err)
 	}
 
-	// Delete metadata for old incidents
-	_, err = tx.Exec(`
-		DELETE FROM incident_sidebar_metadata
-		WHERE incident_id IN (
-			SELECT incident_id FROM incidents
-			WHERE updated_at < ?
-		)
-	`, cutoffDate)
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CleanupOldResolvedIncidents deletes resolved incidents last updated before the
+// cutoff, along with their cached sidebar data. Open and watched incidents are
+// never touched.
+// Returns the number of incidents deleted.
+func (db *DB) CleanupOldResolvedIncidents(cutoffDate time.Time) (int, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	tx, err := db.conn.Begin()
 	if err != nil {
-		return fmt.Errorf("failed to delete old metadata: %w", err)
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	oldResolved := `
+		SELECT incident_id FROM incidents
+		WHERE status = 'resolved' AND updated_at < ? AND COALESCE(watched, 0) = 0
+	`
+
+	for _, table := range []string{"incident_alerts", "incident_notes", "incident_log_entries", "incident_sidebar_metadata"} {
+		_, err = tx.Exec(fmt.Sprintf(`DELETE FROM %s WHERE incident_id IN (%s)`, table, oldResolved), cutoffDate)
+		if err != nil {
+			return 0, fmt.Errorf("failed to delete old %s: %w", table, err)
+		}
 	}
 
-	// Delete the old incidents themselves
 	result, err := tx.Exec(`
 		DELETE FROM incidents
-		WHERE updated_at < ?
+		WHERE status = 'resolved' AND updated_at < ? AND COALESCE(watched, 0) = 0
 	`, cutoffDate)
 	if err != nil {
-		return fmt.Errorf("failed to delete old incidents: %w", err)
+		return 0, fmt.Errorf("failed to delete old resolved incidents: %w", err)
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit cleanup transaction: %w", err)
+		return 0, fmt.Errorf("failed to commit cleanup transaction: %w", err)
 	}
 
-	// Log how many incidents were deleted
 	rowsAffected, _ := result.RowsAffected()
-	if rowsAffected > 0 {
-		// This will be logged by the caller
+	return int(rowsAffected), nil
+}
+
+// Ping runs a trivial query to confirm the connection is usable
+func (db *DB) Ping() error {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var one int
+	if err := db.conn.QueryRow("SELECT 1").Scan(&one); err != nil {
+		return fmt.Errorf("database ping failed: %w", err)
+	}
+	return nil
+}
+
+// Path returns the database file path
+func (db *DB) Path() string {
+	return db.path
+}
+
+// Vacuum rebuilds the database file to reclaim free pages and refreshes query
+// planner statistics. VACUUM cannot run inside a transaction, so it takes the
+// write lock to keep other writers out while it runs. In WAL mode VACUUM
+// writes the whole database through the -wal file, so it is checkpointed and
+// truncated afterwards to actually return the space.
+func (db *DB) Vacuum() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if _, err := db.conn.Exec(`VACUUM`); err != nil {
+		return fmt.Errorf("failed to vacuum database: %w", err)
+	}
+
+	if _, err := db.conn.Exec(`PRAGMA optimize`); err != nil {
+		return fmt.Errorf("failed to optimize database: %w", err)
+	}
+
+	if _, err := db.conn.Exec(`PRAGMA wal_checkpoint(TRUNCATE)`); err != nil {
+		return fmt.Errorf("failed to checkpoint database: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,89 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDeleteIncidentCascadesToSidebarRows(t *testing.T) {
+	db, err := NewDB(filepath.Join(t.TempDir(), "incidents.db"))
+	if err != nil {
+		t.Fatalf("NewDB: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Now()
+	incident := IncidentData{
+		IncidentID: "PINC1",
+		Title:      "Disk full",
+		ServiceID:  "PSVC1",
+		Status:     "triggered",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := db.UpsertIncident(incident); err != nil {
+		t.Fatalf("UpsertIncident: %v", err)
+	}
+	if err := db.StoreIncidentAlerts("PINC1", []SidebarAlert{{ID: "PALERT1", Summary: "disk", Status: "triggered"}}); err != nil {
+		t.Fatalf("StoreIncidentAlerts: %v", err)
+	}
+	if err := db.StoreIncidentNotes("PINC1", []SidebarNote{{ID: "PNOTE1", Content: "looking"}}); err != nil {
+		t.Fatalf("StoreIncidentNotes: %v", err)
+	}
+	if err := db.StoreIncidentLogEntries("PINC1", []SidebarLogEntry{{ID: "PLOG1", Type: "trigger_log_entry", Summary: "Triggered"}}); err != nil {
+		t.Fatalf("StoreIncidentLogEntries: %v", err)
+	}
+	if err := db.StoreSidebarResponders("PINC1", `[]`); err != nil {
+		t.Fatalf("StoreSidebarResponders: %v", err)
+	}
+
+	tables := []string{"incident_alerts", "incident_notes", "incident_log_entries", "incident_sidebar_metadata"}
+	for _, table := range tables {
+		var count int
+		if err := db.conn.QueryRow(`SELECT COUNT(*) FROM `+table+` WHERE incident_id = ?`, "PINC1").Scan(&count); err != nil {
+			t.Fatalf("count %s: %v", table, err)
+		}
+		if count == 0 {
+			t.Fatalf("%s has no rows for the incident before the delete", table)
+		}
+	}
+
+	if _, err := db.conn.Exec(`DELETE FROM incidents WHERE incident_id = ?`, "PINC1"); err != nil {
+		t.Fatalf("delete incident: %v", err)
+	}
+
+	for _, table := range tables {
+		var count int
+		if err := db.conn.QueryRow(`SELECT COUNT(*) FROM `+table+` WHERE incident_id = ?`, "PINC1").Scan(&count); err != nil {
+			t.Fatalf("count %s: %v", table, err)
+		}
+		if count != 0 {
+			t.Errorf("%s has %d rows for the deleted incident, want 0", table, count)
+		}
+	}
+}
+
+func TestSidebarCachingSkipsMissingIncident(t *testing.T) {
+	db, err := NewDB(filepath.Join(t.TempDir(), "incidents.db"))
+	if err != nil {
+		t.Fatalf("NewDB: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.StoreIncidentAlerts("PGONE", []SidebarAlert{{ID: "PALERT1", Summary: "disk", Status: "triggered"}}); err != nil {
+		t.Errorf("StoreIncidentAlerts: %v", err)
+	}
+	if err := db.StoreIncidentNotes("PGONE", []SidebarNote{{ID: "PNOTE1", Content: "looking"}}); err != nil {
+		t.Errorf("StoreIncidentNotes: %v", err)
+	}
+	if err := db.StoreIncidentLogEntries("PGONE", []SidebarLogEntry{{ID: "PLOG1", Type: "trigger_log_entry"}}); err != nil {
+		t.Errorf("StoreIncidentLogEntries: %v", err)
+	}
+	if err := db.StoreSidebarResponders("PGONE", `[]`); err != nil {
+		t.Errorf("StoreSidebarResponders: %v", err)
+	}
+	if err := db.UpdateSidebarMetadata("PGONE", 1, time.Now(), true, true); err != nil {
+		t.Errorf("UpdateSidebarMetadata: %v", err)
+	}
+}
@@ -1,69 +1,253 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 import {main} from '../models';
-import {store} from '../models';
 import {database} from '../models';
+import {store} from '../models';
+
+export function AcknowledgeAllOpen(arg1:Array<string>):Promise<main.BulkAcknowledgeResult>;
 
 export function AcknowledgeIncident(arg1:string):Promise<void>;
 
+export function AcknowledgeIncidentOptimistic(arg1:string):Promise<void>;
+
 export function AddIncidentNote(arg1:string,arg2:main.NoteInput):Promise<void>;
 
+export function AddProfile(arg1:string,arg2:string):Promise<void>;
+
+export function AddResponders(arg1:string,arg2:Array<string>,arg3:string):Promise<void>;
+
+export function CompactDatabase():Promise<number>;
+
 export function ConfigureAPIKey(arg1:string):Promise<void>;
 
+export function DeleteLogFile(arg1:string):Promise<void>;
+
+export function EnableDemoMode(arg1:boolean):Promise<void>;
+
+export function ExportIncidentReport(arg1:string):Promise<string>;
+
+export function ExportIncidents(arg1:string,arg2:string,arg3:string):Promise<string>;
+
+export function ExportSettings():Promise<string>;
+
+export function FindIncidentsByNoteTag(arg1:string,arg2:string):Promise<Array<database.IncidentData>>;
+
+export function ForceRefresh():Promise<void>;
+
 export function GetAPIKey():Promise<string>;
 
+export function GetAPIQueueStats():Promise<Record<string, any>>;
+
+export function GetActiveProfile():Promise<string>;
+
 export function GetAvailableSounds():Promise<Array<string>>;
 
 export function GetBrowserRedirect():Promise<boolean>;
 
+export function GetConnectionStatus():Promise<string>;
+
+export function GetDatabaseInfo():Promise<Record<string, any>>;
+
+export function GetDemoMode():Promise<boolean>;
+
 export function GetFilterByUser():Promise<boolean>;
 
+export function GetGroupedIncidentAlerts(arg1:string):Promise<Array<store.GroupedAlert>>;
+
+export function GetHealthStatus():Promise<Record<string, any>>;
+
+export function GetIncidentAlertSummary(arg1:string):Promise<main.IncidentAlertSummary>;
+
 export function GetIncidentCustomFieldValues(arg1:string):Promise<Array<store.CustomFieldValue>>;
 
 export function GetIncidentCustomFields(arg1:string):Promise<Array<store.CustomField>>;
 
+export function GetIncidentMetrics(arg1:number):Promise<Record<string, any>>;
+
+export function GetIncidentResponders(arg1:string):Promise<Array<store.Responder>>;
+
+export function GetIncidentRetentionDays():Promise<number>;
+
 export function GetIncidentSidebarData(arg1:string):Promise<store.IncidentSidebarData>;
 
+export function GetIncidentSidebarDataFiltered(arg1:string,arg2:boolean):Promise<store.IncidentSidebarData>;
+
+export function GetIncidentTimeline(arg1:string):Promise<Array<store.LogEntry>>;
+
+export function GetIncidentsByServiceName(arg1:string,arg2:string):Promise<Array<database.IncidentData>>;
+
+export function GetIncidentsDelta(arg1:string):Promise<Array<database.IncidentData>>;
+
+export function GetKeyringBackend():Promise<string>;
+
+export function GetLogDedupWindow():Promise<number>;
+
+export function GetLogLevel():Promise<string>;
+
+export function GetNoteTemplate(arg1:string):Promise<store.ServiceTypes>;
+
 export function GetNotificationConfig():Promise<main.NotificationConfig>;
 
+export function GetOnCallForIncident(arg1:string):Promise<Array<store.OnCallEntry>>;
+
+export function GetOpenIncidentSummary():Promise<main.OpenIncidentSummary>;
+
 export function GetOpenIncidents(arg1:Array<string>):Promise<Array<database.IncidentData>>;
 
+export function GetOpenIncidentsFiltered(arg1:Array<string>,arg2:string):Promise<Array<database.IncidentData>>;
+
+export function GetOpenIncidentsGrouped(arg1:Array<string>):Promise<Record<string, Array<database.IncidentData>>>;
+
+export function GetOpenIncidentsSorted(arg1:Array<string>,arg2:string):Promise<Array<database.IncidentData>>;
+
+export function GetOpenIncidentsWithCounts():Promise<main.OpenIncidentsWithCounts>;
+
+export function GetOpenIncidentsWithMeta():Promise<Array<main.IncidentWithMeta>>;
+
+export function GetPendingActions():Promise<Array<database.PendingAction>>;
+
+export function GetPollingIntervals():Promise<Record<string, number>>;
+
+export function GetPriorities():Promise<Array<store.Priority>>;
+
 export function GetRateLimitStatus():Promise<Record<string, any>>;
 
+export function GetRecentLogs(arg1:number):Promise<Array<string>>;
+
+export function GetResolvedDisplayLimit():Promise<number>;
+
 export function GetResolvedIncidents(arg1:Array<string>):Promise<Array<database.IncidentData>>;
 
+export function GetResolvedIncidentsByDateRange(arg1:Array<string>,arg2:string,arg3:string):Promise<Array<database.IncidentData>>;
+
+export function GetResolvedIncidentsPaged(arg1:Array<string>,arg2:number,arg3:number):Promise<main.ResolvedIncidentsPage>;
+
+export function GetResolvedLookbackHours():Promise<number>;
+
+export function GetSLAThresholdMinutes():Promise<number>;
+
 export function GetSelectedServices():Promise<Array<string>>;
 
 export function GetServiceConfigByServiceID(arg1:string):Promise<store.ServiceConfig>;
 
+export function GetServiceIncidentCounts():Promise<Record<string, number>>;
+
 export function GetServiceNameByID(arg1:string):Promise<string>;
 
+export function GetServiceNotificationSounds():Promise<Record<string, string>>;
+
 export function GetServicesConfig():Promise<store.ServicesConfig>;
 
+export function GetServicesWithStatus():Promise<Array<main.ServiceStatus>>;
+
+export function GetSidebarConcurrency():Promise<number>;
+
+export function GetTeamFilter():Promise<Array<string>>;
+
 export function GetTheme():Promise<string>;
 
+export function GetWatchedIncidents():Promise<Array<database.IncidentData>>;
+
+export function ImportSettings(arg1:string):Promise<void>;
+
+export function IsMonitoringAllServices():Promise<boolean>;
+
 export function IsNotificationSnoozed():Promise<boolean>;
 
+export function IsNotificationSupported():Promise<boolean>;
+
+export function IsPaused():Promise<boolean>;
+
+export function IsWebhookServerRunning():Promise<boolean>;
+
+export function ListLogFiles():Promise<Array<main.LogFileInfo>>;
+
+export function ListProfiles():Promise<Array<string>>;
+
+export function ListTeams():Promise<Array<store.Team>>;
+
+export function MarkIncidentResolvedLocally(arg1:string):Promise<void>;
+
+export function OpenIncidentInBrowser(arg1:string):Promise<void>;
+
 export function ReadFile(arg1:string):Promise<string>;
 
+export function RefreshIncidentNotes(arg1:string):Promise<void>;
+
 export function RemoveServicesConfig():Promise<void>;
 
+export function ReopenIncident(arg1:string):Promise<void>;
+
 export function ResolveIncident(arg1:string):Promise<void>;
 
+export function ResyncAll():Promise<void>;
+
+export function SearchUsers(arg1:string):Promise<Array<store.UserSummary>>;
+
+export function SetAPITimeouts(arg1:number,arg2:number):Promise<void>;
+
+export function SetAlertGrowthDelta(arg1:number):Promise<void>;
+
 export function SetBrowserRedirect(arg1:boolean):Promise<void>;
 
+export function SetCircuitBreakerConfig(arg1:number,arg2:number,arg3:number):Promise<void>;
+
 export function SetFilterByUser(arg1:boolean):Promise<void>;
 
 export function SetIncidentCustomFieldValue(arg1:string,arg2:string,arg3:any):Promise<void>;
 
+export function SetIncidentPriority(arg1:string,arg2:string):Promise<void>;
+
+export function SetIncidentRetentionDays(arg1:number):Promise<void>;
+
+export function SetLogDedupWindow(arg1:number):Promise<void>;
+
+export function SetLogLevel(arg1:string):Promise<void>;
+
+export function SetMaxOpenResults(arg1:number):Promise<void>;
+
+export function SetMonitorAllServices(arg1:boolean):Promise<void>;
+
 export function SetNotificationEnabled(arg1:boolean):Promise<void>;
 
+export function SetNotificationMinUrgency(arg1:string):Promise<void>;
+
 export function SetNotificationSound(arg1:string):Promise<void>;
 
+export function SetNotificationTemplates(arg1:string,arg2:string):Promise<void>;
+
+export function SetNotifyOnResolve(arg1:boolean):Promise<void>;
+
+export function SetPaused(arg1:boolean):Promise<void>;
+
+export function SetPollingIntervals(arg1:number,arg2:number,arg3:number):Promise<void>;
+
+export function SetQuietHours(arg1:string,arg2:string,arg3:boolean):Promise<void>;
+
+export function SetRateLimitConfig(arg1:number):Promise<void>;
+
+export function SetResolvedDisplayLimit(arg1:number):Promise<void>;
+
+export function SetResolvedLookbackHours(arg1:number):Promise<void>;
+
+export function SetSLAThresholdMinutes(arg1:number):Promise<void>;
+
 export function SetSelectedServices(arg1:Array<string>):Promise<void>;
 
+export function SetServiceNotificationSound(arg1:string,arg2:string):Promise<void>;
+
+export function SetSidebarConcurrency(arg1:number):Promise<void>;
+
+export function SetStormThreshold(arg1:number):Promise<void>;
+
+export function SetTeamFilter(arg1:Array<string>):Promise<void>;
+
 export function SetTheme(arg1:string):Promise<void>;
 
+export function SetWebhookSecret(arg1:string):Promise<void>;
+
+export function SnoozeIncident(arg1:string,arg2:number):Promise<void>;
+
 export function SnoozeNotificationSound(arg1:number):Promise<void>;
 
 export function StartPolling():Promise<void>;
@@ -72,20 +256,34 @@ export function StartResolvedPolling():Promise<void>;
 
 export function StartUserPolling():Promise<void>;
 
+export function StartWebhookServer(arg1:number):Promise<void>;
+
 export function StopPolling():Promise<void>;
 
 export function StopResolvedPolling():Promise<void>;
 
 export function StopUserPolling():Promise<void>;
 
+export function StopWebhookServer():Promise<void>;
+
+export function SwitchProfile(arg1:string):Promise<void>;
+
+export function TestNotification():Promise<void>;
+
 export function TestNotificationSound():Promise<void>;
 
+export function ToggleIncidentWatch(arg1:string):Promise<boolean>;
+
 export function ToggleServiceDisabled(arg1:any):Promise<void>;
 
+export function ToggleServiceNotificationSuppression(arg1:any):Promise<void>;
+
 export function UnsnoozeNotificationSound():Promise<void>;
 
 export function UploadServicesConfig(arg1:string):Promise<void>;
 
+export function ValidateAPIKey(arg1:string):Promise<string>;
+
 export function ZoomIn():Promise<void>;
 
 export function ZoomOut():Promise<void>;
@@ -2,22 +2,78 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function AcknowledgeAllOpen(arg1) {
+  return window['go']['main']['App']['AcknowledgeAllOpen'](arg1);
+}
+
 export function AcknowledgeIncident(arg1) {
   return window['go']['main']['App']['AcknowledgeIncident'](arg1);
 }
 
+export function AcknowledgeIncidentOptimistic(arg1) {
+  return window['go']['main']['App']['AcknowledgeIncidentOptimistic'](arg1);
+}
+
 export function AddIncidentNote(arg1, arg2) {
   return window['go']['main']['App']['AddIncidentNote'](arg1, arg2);
 }
 
+export function AddProfile(arg1, arg2) {
+  return window['go']['main']['App']['AddProfile'](arg1, arg2);
+}
+
+export function AddResponders(arg1, arg2, arg3) {
+  return window['go']['main']['App']['AddResponders'](arg1, arg2, arg3);
+}
+
+export function CompactDatabase() {
+  return window['go']['main']['App']['CompactDatabase']();
+}
+
 export function ConfigureAPIKey(arg1) {
   return window['go']['main']['App']['ConfigureAPIKey'](arg1);
 }
 
+export function DeleteLogFile(arg1) {
+  return window['go']['main']['App']['DeleteLogFile'](arg1);
+}
+
+export function EnableDemoMode(arg1) {
+  return window['go']['main']['App']['EnableDemoMode'](arg1);
+}
+
+export function ExportIncidentReport(arg1) {
+  return window['go']['main']['App']['ExportIncidentReport'](arg1);
+}
+
+export function ExportIncidents(arg1, arg2, arg3) {
+  return window['go']['main']['App']['ExportIncidents'](arg1, arg2, arg3);
+}
+
+export function ExportSettings() {
+  return window['go']['main']['App']['ExportSettings']();
+}
+
+export function FindIncidentsByNoteTag(arg1, arg2) {
+  return window['go']['main']['App']['FindIncidentsByNoteTag'](arg1, arg2);
+}
+
+export function ForceRefresh() {
+  return window['go']['main']['App']['ForceRefresh']();
+}
+
 export function GetAPIKey() {
   return window['go']['main']['App']['GetAPIKey']();
 }
 
+export function GetAPIQueueStats() {
+  return window['go']['main']['App']['GetAPIQueueStats']();
+}
+
+export function GetActiveProfile() {
+  return window['go']['main']['App']['GetActiveProfile']();
+}
+
 export function GetAvailableSounds() {
   return window['go']['main']['App']['GetAvailableSounds']();
 }
@@ -26,10 +82,34 @@ export function GetBrowserRedirect() {
   return window['go']['main']['App']['GetBrowserRedirect']();
 }
 
+export function GetConnectionStatus() {
+  return window['go']['main']['App']['GetConnectionStatus']();
+}
+
+export function GetDatabaseInfo() {
+  return window['go']['main']['App']['GetDatabaseInfo']();
+}
+
+export function GetDemoMode() {
+  return window['go']['main']['App']['GetDemoMode']();
+}
+
 export function GetFilterByUser() {
   return window['go']['main']['App']['GetFilterByUser']();
 }
 
+export function GetGroupedIncidentAlerts(arg1) {
+  return window['go']['main']['App']['GetGroupedIncidentAlerts'](arg1);
+}
+
+export function GetHealthStatus() {
+  return window['go']['main']['App']['GetHealthStatus']();
+}
+
+export function GetIncidentAlertSummary(arg1) {
+  return window['go']['main']['App']['GetIncidentAlertSummary'](arg1);
+}
+
 export function GetIncidentCustomFieldValues(arg1) {
   return window['go']['main']['App']['GetIncidentCustomFieldValues'](arg1);
 }
@@ -38,26 +118,134 @@ export function GetIncidentCustomFields(arg1) {
   return window['go']['main']['App']['GetIncidentCustomFields'](arg1);
 }
 
+export function GetIncidentMetrics(arg1) {
+  return window['go']['main']['App']['GetIncidentMetrics'](arg1);
+}
+
+export function GetIncidentResponders(arg1) {
+  return window['go']['main']['App']['GetIncidentResponders'](arg1);
+}
+
+export function GetIncidentRetentionDays() {
+  return window['go']['main']['App']['GetIncidentRetentionDays']();
+}
+
 export function GetIncidentSidebarData(arg1) {
   return window['go']['main']['App']['GetIncidentSidebarData'](arg1);
 }
 
+export function GetIncidentSidebarDataFiltered(arg1, arg2) {
+  return window['go']['main']['App']['GetIncidentSidebarDataFiltered'](arg1, arg2);
+}
+
+export function GetIncidentTimeline(arg1) {
+  return window['go']['main']['App']['GetIncidentTimeline'](arg1);
+}
+
+export function GetIncidentsByServiceName(arg1, arg2) {
+  return window['go']['main']['App']['GetIncidentsByServiceName'](arg1, arg2);
+}
+
+export function GetIncidentsDelta(arg1) {
+  return window['go']['main']['App']['GetIncidentsDelta'](arg1);
+}
+
+export function GetKeyringBackend() {
+  return window['go']['main']['App']['GetKeyringBackend']();
+}
+
+export function GetLogDedupWindow() {
+  return window['go']['main']['App']['GetLogDedupWindow']();
+}
+
+export function GetLogLevel() {
+  return window['go']['main']['App']['GetLogLevel']();
+}
+
+export function GetNoteTemplate(arg1) {
+  return window['go']['main']['App']['GetNoteTemplate'](arg1);
+}
+
 export function GetNotificationConfig() {
   return window['go']['main']['App']['GetNotificationConfig']();
 }
 
+export function GetOnCallForIncident(arg1) {
+  return window['go']['main']['App']['GetOnCallForIncident'](arg1);
+}
+
+export function GetOpenIncidentSummary() {
+  return window['go']['main']['App']['GetOpenIncidentSummary']();
+}
+
 export function GetOpenIncidents(arg1) {
   return window['go']['main']['App']['GetOpenIncidents'](arg1);
 }
 
+export function GetOpenIncidentsFiltered(arg1, arg2) {
+  return window['go']['main']['App']['GetOpenIncidentsFiltered'](arg1, arg2);
+}
+
+export function GetOpenIncidentsGrouped(arg1) {
+  return window['go']['main']['App']['GetOpenIncidentsGrouped'](arg1);
+}
+
+export function GetOpenIncidentsSorted(arg1, arg2) {
+  return window['go']['main']['App']['GetOpenIncidentsSorted'](arg1, arg2);
+}
+
+export function GetOpenIncidentsWithCounts() {
+  return window['go']['main']['App']['GetOpenIncidentsWithCounts']();
+}
+
+export function GetOpenIncidentsWithMeta() {
+  return window['go']['main']['App']['GetOpenIncidentsWithMeta']();
+}
+
+export function GetPendingActions() {
+  return window['go']['main']['App']['GetPendingActions']();
+}
+
+export function GetPollingIntervals() {
+  return window['go']['main']['App']['GetPollingIntervals']();
+}
+
+export function GetPriorities() {
+  return window['go']['main']['App']['GetPriorities']();
+}
+
 export function GetRateLimitStatus() {
   return window['go']['main']['App']['GetRateLimitStatus']();
 }
 
+export function GetRecentLogs(arg1) {
+  return window['go']['main']['App']['GetRecentLogs'](arg1);
+}
+
+export function GetResolvedDisplayLimit() {
+  return window['go']['main']['App']['GetResolvedDisplayLimit']();
+}
+
 export function GetResolvedIncidents(arg1) {
   return window['go']['main']['App']['GetResolvedIncidents'](arg1);
 }
 
+export function GetResolvedIncidentsByDateRange(arg1, arg2, arg3) {
+  return window['go']['main']['App']['GetResolvedIncidentsByDateRange'](arg1, arg2, arg3);
+}
+
+export function GetResolvedIncidentsPaged(arg1, arg2, arg3) {
+  return window['go']['main']['App']['GetResolvedIncidentsPaged'](arg1, arg2, arg3);
+}
+
+export function GetResolvedLookbackHours() {
+  return window['go']['main']['App']['GetResolvedLookbackHours']();
+}
+
+export function GetSLAThresholdMinutes() {
+  return window['go']['main']['App']['GetSLAThresholdMinutes']();
+}
+
 export function GetSelectedServices() {
   return window['go']['main']['App']['GetSelectedServices']();
 }
@@ -66,38 +254,130 @@ export function GetServiceConfigByServiceID(arg1) {
   return window['go']['main']['App']['GetServiceConfigByServiceID'](arg1);
 }
 
+export function GetServiceIncidentCounts() {
+  return window['go']['main']['App']['GetServiceIncidentCounts']();
+}
+
 export function GetServiceNameByID(arg1) {
   return window['go']['main']['App']['GetServiceNameByID'](arg1);
 }
 
+export function GetServiceNotificationSounds() {
+  return window['go']['main']['App']['GetServiceNotificationSounds']();
+}
+
 export function GetServicesConfig() {
   return window['go']['main']['App']['GetServicesConfig']();
 }
 
+export function GetServicesWithStatus() {
+  return window['go']['main']['App']['GetServicesWithStatus']();
+}
+
+export function GetSidebarConcurrency() {
+  return window['go']['main']['App']['GetSidebarConcurrency']();
+}
+
+export function GetTeamFilter() {
+  return window['go']['main']['App']['GetTeamFilter']();
+}
+
 export function GetTheme() {
   return window['go']['main']['App']['GetTheme']();
 }
 
+export function GetWatchedIncidents() {
+  return window['go']['main']['App']['GetWatchedIncidents']();
+}
+
+export function ImportSettings(arg1) {
+  return window['go']['main']['App']['ImportSettings'](arg1);
+}
+
+export function IsMonitoringAllServices() {
+  return window['go']['main']['App']['IsMonitoringAllServices']();
+}
+
 export function IsNotificationSnoozed() {
   return window['go']['main']['App']['IsNotificationSnoozed']();
 }
 
+export function IsNotificationSupported() {
+  return window['go']['main']['App']['IsNotificationSupported']();
+}
+
+export function IsPaused() {
+  return window['go']['main']['App']['IsPaused']();
+}
+
+export function IsWebhookServerRunning() {
+  return window['go']['main']['App']['IsWebhookServerRunning']();
+}
+
+export function ListLogFiles() {
+  return window['go']['main']['App']['ListLogFiles']();
+}
+
+export function ListProfiles() {
+  return window['go']['main']['App']['ListProfiles']();
+}
+
+export function ListTeams() {
+  return window['go']['main']['App']['ListTeams']();
+}
+
+export function MarkIncidentResolvedLocally(arg1) {
+  return window['go']['main']['App']['MarkIncidentResolvedLocally'](arg1);
+}
+
+export function OpenIncidentInBrowser(arg1) {
+  return window['go']['main']['App']['OpenIncidentInBrowser'](arg1);
+}
+
 export function ReadFile(arg1) {
   return window['go']['main']['App']['ReadFile'](arg1);
 }
 
+export function RefreshIncidentNotes(arg1) {
+  return window['go']['main']['App']['RefreshIncidentNotes'](arg1);
+}
+
 export function RemoveServicesConfig() {
   return window['go']['main']['App']['RemoveServicesConfig']();
 }
 
+export function ReopenIncident(arg1) {
+  return window['go']['main']['App']['ReopenIncident'](arg1);
+}
+
 export function ResolveIncident(arg1) {
   return window['go']['main']['App']['ResolveIncident'](arg1);
 }
 
+export function ResyncAll() {
+  return window['go']['main']['App']['ResyncAll']();
+}
+
+export function SearchUsers(arg1) {
+  return window['go']['main']['App']['SearchUsers'](arg1);
+}
+
+export function SetAPITimeouts(arg1, arg2) {
+  return window['go']['main']['App']['SetAPITimeouts'](arg1, arg2);
+}
+
+export function SetAlertGrowthDelta(arg1) {
+  return window['go']['main']['App']['SetAlertGrowthDelta'](arg1);
+}
+
 export function SetBrowserRedirect(arg1) {
   return window['go']['main']['App']['SetBrowserRedirect'](arg1);
 }
 
+export function SetCircuitBreakerConfig(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SetCircuitBreakerConfig'](arg1, arg2, arg3);
+}
+
 export function SetFilterByUser(arg1) {
   return window['go']['main']['App']['SetFilterByUser'](arg1);
 }
@@ -106,22 +386,110 @@ export function SetIncidentCustomFieldValue(arg1, arg2, arg3) {
   return window['go']['main']['App']['SetIncidentCustomFieldValue'](arg1, arg2, arg3);
 }
 
+export function SetIncidentPriority(arg1, arg2) {
+  return window['go']['main']['App']['SetIncidentPriority'](arg1, arg2);
+}
+
+export function SetIncidentRetentionDays(arg1) {
+  return window['go']['main']['App']['SetIncidentRetentionDays'](arg1);
+}
+
+export function SetLogDedupWindow(arg1) {
+  return window['go']['main']['App']['SetLogDedupWindow'](arg1);
+}
+
+export function SetLogLevel(arg1) {
+  return window['go']['main']['App']['SetLogLevel'](arg1);
+}
+
+export function SetMaxOpenResults(arg1) {
+  return window['go']['main']['App']['SetMaxOpenResults'](arg1);
+}
+
+export function SetMonitorAllServices(arg1) {
+  return window['go']['main']['App']['SetMonitorAllServices'](arg1);
+}
+
 export function SetNotificationEnabled(arg1) {
   return window['go']['main']['App']['SetNotificationEnabled'](arg1);
 }
 
+export function SetNotificationMinUrgency(arg1) {
+  return window['go']['main']['App']['SetNotificationMinUrgency'](arg1);
+}
+
 export function SetNotificationSound(arg1) {
   return window['go']['main']['App']['SetNotificationSound'](arg1);
 }
 
+export function SetNotificationTemplates(arg1, arg2) {
+  return window['go']['main']['App']['SetNotificationTemplates'](arg1, arg2);
+}
+
+export function SetNotifyOnResolve(arg1) {
+  return window['go']['main']['App']['SetNotifyOnResolve'](arg1);
+}
+
+export function SetPaused(arg1) {
+  return window['go']['main']['App']['SetPaused'](arg1);
+}
+
+export function SetPollingIntervals(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SetPollingIntervals'](arg1, arg2, arg3);
+}
+
+export function SetQuietHours(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SetQuietHours'](arg1, arg2, arg3);
+}
+
+export function SetRateLimitConfig(arg1) {
+  return window['go']['main']['App']['SetRateLimitConfig'](arg1);
+}
+
+export function SetResolvedDisplayLimit(arg1) {
+  return window['go']['main']['App']['SetResolvedDisplayLimit'](arg1);
+}
+
+export function SetResolvedLookbackHours(arg1) {
+  return window['go']['main']['App']['SetResolvedLookbackHours'](arg1);
+}
+
+export function SetSLAThresholdMinutes(arg1) {
+  return window['go']['main']['App']['SetSLAThresholdMinutes'](arg1);
+}
+
 export function SetSelectedServices(arg1) {
   return window['go']['main']['App']['SetSelectedServices'](arg1);
 }
 
+export function SetServiceNotificationSound(arg1, arg2) {
+  return window['go']['main']['App']['SetServiceNotificationSound'](arg1, arg2);
+}
+
+export function SetSidebarConcurrency(arg1) {
+  return window['go']['main']['App']['SetSidebarConcurrency'](arg1);
+}
+
+export function SetStormThreshold(arg1) {
+  return window['go']['main']['App']['SetStormThreshold'](arg1);
+}
+
+export function SetTeamFilter(arg1) {
+  return window['go']['main']['App']['SetTeamFilter'](arg1);
+}
+
 export function SetTheme(arg1) {
   return window['go']['main']['App']['SetTheme'](arg1);
 }
 
+export function SetWebhookSecret(arg1) {
+  return window['go']['main']['App']['SetWebhookSecret'](arg1);
+}
+
+export function SnoozeIncident(arg1, arg2) {
+  return window['go']['main']['App']['SnoozeIncident'](arg1, arg2);
+}
+
 export function SnoozeNotificationSound(arg1) {
   return window['go']['main']['App']['SnoozeNotificationSound'](arg1);
 }
@@ -138,6 +506,10 @@ export function StartUserPolling() {
   return window['go']['main']['App']['StartUserPolling']();
 }
 
+export function StartWebhookServer(arg1) {
+  return window['go']['main']['App']['StartWebhookServer'](arg1);
+}
+
 export function StopPolling() {
   return window['go']['main']['App']['StopPolling']();
 }
@@ -150,14 +522,34 @@ export function StopUserPolling() {
   return window['go']['main']['App']['StopUserPolling']();
 }
 
+export function StopWebhookServer() {
+  return window['go']['main']['App']['StopWebhookServer']();
+}
+
+export function SwitchProfile(arg1) {
+  return window['go']['main']['App']['SwitchProfile'](arg1);
+}
+
+export function TestNotification() {
+  return window['go']['main']['App']['TestNotification']();
+}
+
 export function TestNotificationSound() {
   return window['go']['main']['App']['TestNotificationSound']();
 }
 
+export function ToggleIncidentWatch(arg1) {
+  return window['go']['main']['App']['ToggleIncidentWatch'](arg1);
+}
+
 export function ToggleServiceDisabled(arg1) {
   return window['go']['main']['App']['ToggleServiceDisabled'](arg1);
 }
 
+export function ToggleServiceNotificationSuppression(arg1) {
+  return window['go']['main']['App']['ToggleServiceNotificationSuppression'](arg1);
+}
+
 export function UnsnoozeNotificationSound() {
   return window['go']['main']['App']['UnsnoozeNotificationSound']();
 }
@@ -166,6 +558,10 @@ export function UploadServicesConfig(arg1) {
   return window['go']['main']['App']['UploadServicesConfig'](arg1);
 }
 
+export function ValidateAPIKey(arg1) {
+  return window['go']['main']['App']['ValidateAPIKey'](arg1);
+}
+
 export function ZoomIn() {
   return window['go']['main']['App']['ZoomIn']();
 }
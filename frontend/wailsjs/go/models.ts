@@ -15,6 +15,15 @@ export namespace database {
 	    alert_count: number;
 	    urgency: string;
 	    acknowledged_by: string;
+	    priority_id: string;
+	    priority_name: string;
+	    // Go type: time
+	    acknowledged_at?: any;
+	    // Go type: time
+	    resolved_at?: any;
+	    assignees: string;
+	    watched: boolean;
+	    acked_by: string;
 	    assigned_to_me: boolean;
 	
 	    static createFrom(source: any = {}) {
@@ -35,6 +44,13 @@ export namespace database {
 	        this.alert_count = source["alert_count"];
 	        this.urgency = source["urgency"];
 	        this.acknowledged_by = source["acknowledged_by"];
+	        this.priority_id = source["priority_id"];
+	        this.priority_name = source["priority_name"];
+	        this.acknowledged_at = this.convertValues(source["acknowledged_at"], null);
+	        this.resolved_at = this.convertValues(source["resolved_at"], null);
+	        this.assignees = source["assignees"];
+	        this.watched = source["watched"];
+	        this.acked_by = source["acked_by"];
 	        this.assigned_to_me = source["assigned_to_me"];
 	    }
 	
@@ -56,15 +72,188 @@ export namespace database {
 		    return a;
 		}
 	}
+	export class PendingAction {
+	    id: number;
+	    action_type: string;
+	    incident_id: string;
+	    payload: string;
+	    attempts: number;
+	    last_error: string;
+	    // Go type: time
+	    next_attempt_at: any;
+	    // Go type: time
+	    created_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new PendingAction(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.action_type = source["action_type"];
+	        this.incident_id = source["incident_id"];
+	        this.payload = source["payload"];
+	        this.attempts = source["attempts"];
+	        this.last_error = source["last_error"];
+	        this.next_attempt_at = this.convertValues(source["next_attempt_at"], null);
+	        this.created_at = this.convertValues(source["created_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 
 }
 
 export namespace main {
 	
+	export class BulkAcknowledgeResult {
+	    acknowledged: number;
+	    total: number;
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new BulkAcknowledgeResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.acknowledged = source["acknowledged"];
+	        this.total = source["total"];
+	        this.errors = source["errors"];
+	    }
+	}
+	export class IncidentAlertSummary {
+	    incident_id: string;
+	    triggered: number;
+	    resolved: number;
+	    total: number;
+	    // Go type: time
+	    fetched_at?: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new IncidentAlertSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.incident_id = source["incident_id"];
+	        this.triggered = source["triggered"];
+	        this.resolved = source["resolved"];
+	        this.total = source["total"];
+	        this.fetched_at = this.convertValues(source["fetched_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class IncidentWithMeta {
+	    incident: database.IncidentData;
+	    age_seconds: number;
+	    sla_breached: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new IncidentWithMeta(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.incident = this.convertValues(source["incident"], database.IncidentData);
+	        this.age_seconds = source["age_seconds"];
+	        this.sla_breached = source["sla_breached"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LogFileInfo {
+	    name: string;
+	    size: number;
+	    // Go type: time
+	    mod_time: any;
+	    current: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new LogFileInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.size = source["size"];
+	        this.mod_time = this.convertValues(source["mod_time"], null);
+	        this.current = source["current"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class NoteInput {
 	    responses: store.NoteResponse[];
 	    tags: store.NoteTag[];
 	    freeform_content: string;
+	    allow_duplicate: boolean;
 	
 	    static createFrom(source: any = {}) {
 	        return new NoteInput(source);
@@ -75,6 +264,7 @@ export namespace main {
 	        this.responses = this.convertValues(source["responses"], store.NoteResponse);
 	        this.tags = this.convertValues(source["tags"], store.NoteTag);
 	        this.freeform_content = source["freeform_content"];
+	        this.allow_duplicate = source["allow_duplicate"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -102,6 +292,13 @@ export namespace main {
 	    // Go type: time
 	    snoozeUntil: any;
 	    browserRedirect: boolean;
+	    minUrgency: string;
+	    notifyOnResolve: boolean;
+	    quietHoursEnabled: boolean;
+	    quietHoursStart: string;
+	    quietHoursEnd: string;
+	    titleTemplate: string;
+	    messageTemplate: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new NotificationConfig(source);
@@ -114,6 +311,97 @@ export namespace main {
 	        this.snoozed = source["snoozed"];
 	        this.snoozeUntil = this.convertValues(source["snoozeUntil"], null);
 	        this.browserRedirect = source["browserRedirect"];
+	        this.minUrgency = source["minUrgency"];
+	        this.notifyOnResolve = source["notifyOnResolve"];
+	        this.quietHoursEnabled = source["quietHoursEnabled"];
+	        this.quietHoursStart = source["quietHoursStart"];
+	        this.quietHoursEnd = source["quietHoursEnd"];
+	        this.titleTemplate = source["titleTemplate"];
+	        this.messageTemplate = source["messageTemplate"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class OpenIncidentSummary {
+	    triggered: number;
+	    acknowledged: number;
+	    high_urgency_triggered: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new OpenIncidentSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.triggered = source["triggered"];
+	        this.acknowledged = source["acknowledged"];
+	        this.high_urgency_triggered = source["high_urgency_triggered"];
+	    }
+	}
+	export class OpenIncidentsWithCounts {
+	    incidents: database.IncidentData[];
+	    note_counts: Record<string, number>;
+	
+	    static createFrom(source: any = {}) {
+	        return new OpenIncidentsWithCounts(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.incidents = this.convertValues(source["incidents"], database.IncidentData);
+	        this.note_counts = source["note_counts"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ResolvedIncidentsPage {
+	    incidents: database.IncidentData[];
+	    total: number;
+	    limit: number;
+	    offset: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ResolvedIncidentsPage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.incidents = this.convertValues(source["incidents"], database.IncidentData);
+	        this.total = source["total"];
+	        this.limit = source["limit"];
+	        this.offset = source["offset"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -134,6 +422,26 @@ export namespace main {
 		    return a;
 		}
 	}
+	export class ServiceStatus {
+	    name: string;
+	    service_ids: string[];
+	    disabled: boolean;
+	    suppress_notifications: boolean;
+	    open_count: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ServiceStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.service_ids = source["service_ids"];
+	        this.disabled = source["disabled"];
+	        this.suppress_notifications = source["suppress_notifications"];
+	        this.open_count = source["open_count"];
+	    }
+	}
 
 }
 
@@ -270,6 +578,45 @@ export namespace store {
 		    return a;
 		}
 	}
+	export class GroupedAlert {
+	    summary: string;
+	    count: number;
+	    latest_created_at: string;
+	    statuses: string[];
+	    alerts: IncidentAlert[];
+	
+	    static createFrom(source: any = {}) {
+	        return new GroupedAlert(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.summary = source["summary"];
+	        this.count = source["count"];
+	        this.latest_created_at = source["latest_created_at"];
+	        this.statuses = source["statuses"];
+	        this.alerts = this.convertValues(source["alerts"], IncidentAlert);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
 	export class NoteTag {
 	    tag_name: string;
 	    selected_values: string[];
@@ -342,10 +689,25 @@ export namespace store {
 		    return a;
 		}
 	}
+	export class Responder {
+	    name: string;
+	    state: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Responder(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.state = source["state"];
+	    }
+	}
 	export class IncidentSidebarData {
 	    incident_id: string;
 	    alerts: IncidentAlert[];
 	    notes: IncidentNote[];
+	    responders?: Responder[];
 	    loading: boolean;
 	    error?: string;
 	
@@ -358,6 +720,7 @@ export namespace store {
 	        this.incident_id = source["incident_id"];
 	        this.alerts = this.convertValues(source["alerts"], IncidentAlert);
 	        this.notes = this.convertValues(source["notes"], IncidentNote);
+	        this.responders = this.convertValues(source["responders"], Responder);
 	        this.loading = source["loading"];
 	        this.error = source["error"];
 	    }
@@ -380,7 +743,66 @@ export namespace store {
 		    return a;
 		}
 	}
+	export class LogEntry {
+	    id: string;
+	    type: string;
+	    summary: string;
+	    created_at: string;
+	    agent?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LogEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.type = source["type"];
+	        this.summary = source["summary"];
+	        this.created_at = source["created_at"];
+	        this.agent = source["agent"];
+	    }
+	}
+	
 	
+	export class OnCallEntry {
+	    user_id: string;
+	    user_name: string;
+	    escalation_level: number;
+	    schedule_name?: string;
+	    start?: string;
+	    end?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new OnCallEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.user_id = source["user_id"];
+	        this.user_name = source["user_name"];
+	        this.escalation_level = source["escalation_level"];
+	        this.schedule_name = source["schedule_name"];
+	        this.start = source["start"];
+	        this.end = source["end"];
+	    }
+	}
+	export class Priority {
+	    id: string;
+	    name: string;
+	    description?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Priority(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.description = source["description"];
+	    }
+	}
 	
 	export class TagConfig {
 	    name: string;
@@ -435,6 +857,7 @@ export namespace store {
 	    name: string;
 	    disabled?: boolean;
 	    types?: ServiceTypes;
+	    suppress_notifications?: boolean;
 	
 	    static createFrom(source: any = {}) {
 	        return new ServiceConfig(source);
@@ -446,6 +869,7 @@ export namespace store {
 	        this.name = source["name"];
 	        this.disabled = source["disabled"];
 	        this.types = this.convertValues(source["types"], ServiceTypes);
+	        this.suppress_notifications = source["suppress_notifications"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -497,6 +921,37 @@ export namespace store {
 		    return a;
 		}
 	}
+	
+	export class Team {
+	    id: string;
+	    name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Team(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	    }
+	}
+	export class UserSummary {
+	    id: string;
+	    name: string;
+	    email: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new UserSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.email = source["email"];
+	    }
+	}
 
 }
 
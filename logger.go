@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 )
@@ -21,15 +22,21 @@ const (
 
 // Logger handles file-based logging for the application
 type Logger struct {
-	file       *os.File
-	logger     *log.Logger
-	mu         sync.Mutex
-	logLevel   LogLevel
-	lastLogMsg string
-	lastLogTime time.Time
-	repeatCount int
+	file         *os.File
+	path         string
+	logger       *log.Logger
+	mu           sync.Mutex
+	logLevel     LogLevel
+	lastLogMsg   string
+	lastLogLevel LogLevel
+	lastLogTime  time.Time
+	repeatCount  int
+	dedupWindow  time.Duration // identical messages within this window are counted, not written; 0 disables
 }
 
+// defaultDedupWindow is how long identical messages are collapsed by default
+const defaultDedupWindow = 5 * time.Second
+
 // NewLogger creates a new file logger
 func NewLogger() (*Logger, error) {
 	// Get user's home directory
@@ -54,9 +61,11 @@ func NewLogger() (*Logger, error) {
 	logger := log.New(file, "", 0)
 
 	l := &Logger{
-		file:     file,
-		logger:   logger,
-		logLevel: INFO, // Default to INFO level
+		file:        file,
+		path:        logPath,
+		logger:      logger,
+		logLevel:    INFO, // Default to INFO level
+		dedupWindow: defaultDedupWindow,
 	}
 
 	// Write startup message
@@ -67,6 +76,14 @@ func NewLogger() (*Logger, error) {
 	return l, nil
 }
 
+// LogFilePath returns the path of the current log file
+func (l *Logger) LogFilePath() string {
+	if l == nil {
+		return ""
+	}
+	return l.path
+}
+
 // SetLogLevel sets the minimum log level
 func (l *Logger) SetLogLevel(level LogLevel) {
 	l.mu.Lock()
@@ -74,6 +91,75 @@ func (l *Logger) SetLogLevel(level LogLevel) {
 	l.logLevel = level
 }
 
+// GetLogLevel returns the current minimum log level
+func (l *Logger) GetLogLevel() LogLevel {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.logLevel
+}
+
+// SetDedupWindow sets how long identical consecutive messages are collapsed
+// into a repeat count. 0 disables deduplication so every message is written.
+func (l *Logger) SetDedupWindow(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if d < 0 {
+		d = 0
+	}
+	l.dedupWindow = d
+	// Don't leave a count behind that the new window would never flush
+	l.flushRepeats()
+}
+
+// GetDedupWindow returns the current deduplication window
+func (l *Logger) GetDedupWindow() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.dedupWindow
+}
+
+// flushRepeats writes the pending repeat count, if any. Callers must hold l.mu.
+func (l *Logger) flushRepeats() {
+	if l.repeatCount == 0 {
+		return
+	}
+	timestamp := l.lastLogTime.Format("2006-01-02 15:04:05")
+	l.logger.Printf("[%s] %s (repeated %d times)\n", timestamp, l.getLevelString(l.lastLogLevel), l.repeatCount)
+	l.repeatCount = 0
+}
+
+// ParseLogLevel converts "debug", "info", "warn" or "error" to a LogLevel
+func ParseLogLevel(level string) (LogLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return DEBUG, nil
+	case "info":
+		return INFO, nil
+	case "warn", "warning":
+		return WARN, nil
+	case "error":
+		return ERROR, nil
+	default:
+		return INFO, fmt.Errorf("invalid log level: %s", level)
+	}
+}
+
+// String returns the lowercase name of a log level, as accepted by ParseLogLevel
+func (level LogLevel) String() string {
+	switch level {
+	case DEBUG:
+		return "debug"
+	case INFO:
+		return "info"
+	case WARN:
+		return "warn"
+	case ERROR:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
 // writeLog writes a log message with deduplication
 func (l *Logger) writeLog(level LogLevel, message string) {
 	l.mu.Lock()
@@ -86,18 +172,13 @@ func (l *Logger) writeLog(level LogLevel, message string) {
 
 	// Deduplicate repetitive messages
 	now := time.Now()
-	if message == l.lastLogMsg && now.Sub(l.lastLogTime) < 5*time.Second {
+	if l.dedupWindow > 0 && message == l.lastLogMsg && level == l.lastLogLevel && now.Sub(l.lastLogTime) < l.dedupWindow {
 		l.repeatCount++
 		return
 	}
 
 	// If we had repeated messages, log the count
-	if l.repeatCount > 0 {
-		levelStr := l.getLevelString(level)
-		timestamp := l.lastLogTime.Format("2006-01-02 15:04:05")
-		l.logger.Printf("[%s] %s (repeated %d times)\n", timestamp, levelStr, l.repeatCount)
-		l.repeatCount = 0
-	}
+	l.flushRepeats()
 
 	// Log the new message
 	levelStr := l.getLevelString(level)
@@ -105,6 +186,7 @@ func (l *Logger) writeLog(level LogLevel, message string) {
 	l.logger.Printf("[%s] %s %s\n", timestamp, levelStr, message)
 
 	l.lastLogMsg = message
+	l.lastLogLevel = level
 	l.lastLogTime = now
 }
 
@@ -166,10 +248,7 @@ func (l *Logger) Close() error {
 	defer l.mu.Unlock()
 
 	// Write final repeated count if any
-	if l.repeatCount > 0 {
-		timestamp := l.lastLogTime.Format("2006-01-02 15:04:05")
-		l.logger.Printf("[%s] [INFO ] (repeated %d times)\n", timestamp, l.repeatCount)
-	}
+	l.flushRepeats()
 
 	// Write shutdown message
 	timestamp := time.Now().Format("2006-01-02 15:04:05")
@@ -242,4 +321,4 @@ func (l *Logger) cleanOldLogs(logDir string) {
 			os.Remove(oldFile)
 		}
 	}
-}
\ No newline at end of file
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"pager-ops/database"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -17,6 +19,19 @@ type NotificationConfig struct {
 	Snoozed         bool      `json:"snoozed"`
 	SnoozeUntil     time.Time `json:"snoozeUntil"`
 	BrowserRedirect bool      `json:"browserRedirect"`
+	MinUrgency      string    `json:"minUrgency"` // "low" notifies on everything, "high" only on high urgency
+	// Quiet notification when an incident assigned to the user is resolved
+	NotifyOnResolve bool `json:"notifyOnResolve"`
+
+	// Recurring daily window ("HH:MM" local time) during which sounds are muted
+	QuietHoursEnabled bool   `json:"quietHoursEnabled"`
+	QuietHoursStart   string `json:"quietHoursStart"`
+	QuietHoursEnd     string `json:"quietHoursEnd"`
+
+	// Optional templates for incident notifications. Supported placeholders:
+	// {service}, {title}, {urgency}, {number}. Empty means the default text.
+	TitleTemplate   string `json:"titleTemplate"`
+	MessageTemplate string `json:"messageTemplate"`
 }
 
 // SoundRequest represents a sound playback request
@@ -46,6 +61,8 @@ type NotificationManager struct {
 	wg                 sync.WaitGroup
 	processedIncidents map[string]time.Time
 	processedMu        sync.RWMutex
+	serviceSounds      map[string]string // service ID -> sound file, overrides config.Sound
+	ackCallback        func(incidentID string)
 }
 
 // RateLimiter implements a simple rate limiting mechanism
@@ -110,6 +127,7 @@ func NewNotificationManager(logger *Logger) *NotificationManager {
 			Sound:           "default",
 			Snoozed:         false,
 			BrowserRedirect: false, // Default OFF
+			MinUrgency:      "low",
 		},
 		logger:              logger,
 		soundQueue:          make(chan SoundRequest, 100),
@@ -118,6 +136,7 @@ func NewNotificationManager(logger *Logger) *NotificationManager {
 		redirectRateLimiter: NewRedirectRateLimiter(),
 		shutdownCh:          make(chan struct{}),
 		processedIncidents:  make(map[string]time.Time),
+		serviceSounds:       make(map[string]string),
 	}
 
 	// Start the workers
@@ -254,6 +273,38 @@ func (nm *NotificationManager) GetConfig() NotificationConfig {
 	return nm.config
 }
 
+// SetAckCallback sets the handler invoked when the "Acknowledge" action is
+// clicked on an incident notification. Only terminal-notifier supports actions.
+func (nm *NotificationManager) SetAckCallback(callback func(incidentID string)) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+	nm.ackCallback = callback
+}
+
+// LoadConfig applies a previously saved config in one step. A snooze that has
+// already expired is dropped so notifications aren't silenced after a restart.
+func (nm *NotificationManager) LoadConfig(cfg NotificationConfig) {
+	if cfg.Snoozed && time.Now().After(cfg.SnoozeUntil) {
+		cfg.Snoozed = false
+		cfg.SnoozeUntil = time.Time{}
+	}
+	if cfg.Sound == "" {
+		cfg.Sound = "default"
+	}
+	if cfg.MinUrgency != "high" {
+		cfg.MinUrgency = "low"
+	}
+
+	nm.mu.Lock()
+	nm.config = cfg
+	nm.mu.Unlock()
+
+	if nm.logger != nil {
+		nm.logger.Info(fmt.Sprintf("Notification config loaded: enabled=%v, sound=%s, snoozed=%v, browserRedirect=%v",
+			cfg.Enabled, cfg.Sound, cfg.Snoozed, cfg.BrowserRedirect))
+	}
+}
+
 func (nm *NotificationManager) SetEnabled(enabled bool) {
 	nm.mu.Lock()
 	defer nm.mu.Unlock()
@@ -263,6 +314,43 @@ func (nm *NotificationManager) SetEnabled(enabled bool) {
 	}
 }
 
+// SetMinUrgency sets the lowest incident urgency ("low" or "high") that triggers notifications
+func (nm *NotificationManager) SetMinUrgency(urgency string) error {
+	if urgency != "low" && urgency != "high" {
+		return fmt.Errorf("invalid urgency: %s", urgency)
+	}
+
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+	nm.config.MinUrgency = urgency
+	if nm.logger != nil {
+		nm.logger.Info(fmt.Sprintf("Notification minimum urgency: %s", urgency))
+	}
+	return nil
+}
+
+// MeetsMinUrgency reports whether an incident with the given urgency should notify
+func (nm *NotificationManager) MeetsMinUrgency(urgency string) bool {
+	nm.mu.RLock()
+	defer nm.mu.RUnlock()
+
+	if nm.config.MinUrgency == "high" {
+		return urgency == "high"
+	}
+	return true
+}
+
+// SetNotifyOnResolve toggles the silent notification for resolved incidents
+// that were assigned to the current user
+func (nm *NotificationManager) SetNotifyOnResolve(enabled bool) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+	nm.config.NotifyOnResolve = enabled
+	if nm.logger != nil {
+		nm.logger.Info(fmt.Sprintf("Notify on resolve: %v", enabled))
+	}
+}
+
 func (nm *NotificationManager) SetBrowserRedirect(enabled bool) {
 	nm.mu.Lock()
 	defer nm.mu.Unlock()
@@ -276,24 +364,82 @@ func (nm *NotificationManager) SetSound(sound string) {
 	nm.mu.Lock()
 	defer nm.mu.Unlock()
 
-	// If it's not default and doesn't have an extension, try to find the file
-	if sound != "default" && !strings.Contains(sound, ".") {
-		soundsDir := filepath.Join(".", "assets", "sounds")
-		entries, err := os.ReadDir(soundsDir)
-		if err == nil {
-			for _, entry := range entries {
-				name := entry.Name()
-				nameWithoutExt := strings.TrimSuffix(name, filepath.Ext(name))
-				if nameWithoutExt == sound {
-					sound = name // Use the full filename with extension
-					break
-				}
+	sound = resolveSoundName(sound)
+	nm.config.Sound = sound
+	nm.logger.Info(fmt.Sprintf("Notification sound set to: %s", sound))
+}
+
+// resolveSoundName maps an extensionless sound name to its file in the sounds
+// directory. "default" and names that already have an extension are unchanged.
+func resolveSoundName(sound string) string {
+	if sound == "default" || strings.Contains(sound, ".") {
+		return sound
+	}
+
+	soundsDir := filepath.Join(".", "assets", "sounds")
+	entries, err := os.ReadDir(soundsDir)
+	if err == nil {
+		for _, entry := range entries {
+			name := entry.Name()
+			nameWithoutExt := strings.TrimSuffix(name, filepath.Ext(name))
+			if nameWithoutExt == sound {
+				return name // Use the full filename with extension
 			}
 		}
 	}
+	return sound
+}
 
-	nm.config.Sound = sound
-	nm.logger.Info(fmt.Sprintf("Notification sound set to: %s", sound))
+// SetServiceSound sets the sound used for a specific service's notifications.
+// An empty sound removes the override so the global sound is used.
+func (nm *NotificationManager) SetServiceSound(serviceID, sound string) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	if sound == "" {
+		delete(nm.serviceSounds, serviceID)
+		nm.logger.Info(fmt.Sprintf("Notification sound override removed for service %s", serviceID))
+		return
+	}
+
+	sound = resolveSoundName(sound)
+	nm.serviceSounds[serviceID] = sound
+	nm.logger.Info(fmt.Sprintf("Notification sound for service %s set to: %s", serviceID, sound))
+}
+
+// GetServiceSounds returns a copy of the per-service sound overrides
+func (nm *NotificationManager) GetServiceSounds() map[string]string {
+	nm.mu.RLock()
+	defer nm.mu.RUnlock()
+
+	sounds := make(map[string]string, len(nm.serviceSounds))
+	for id, sound := range nm.serviceSounds {
+		sounds[id] = sound
+	}
+	return sounds
+}
+
+// LoadServiceSounds replaces the per-service sound overrides
+func (nm *NotificationManager) LoadServiceSounds(sounds map[string]string) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	nm.serviceSounds = make(map[string]string, len(sounds))
+	for id, sound := range sounds {
+		nm.serviceSounds[id] = sound
+	}
+}
+
+// SoundForService returns the sound configured for a service, falling back to
+// the global notification sound.
+func (nm *NotificationManager) SoundForService(serviceID string) string {
+	nm.mu.RLock()
+	defer nm.mu.RUnlock()
+
+	if sound, ok := nm.serviceSounds[serviceID]; ok && sound != "" {
+		return sound
+	}
+	return nm.config.Sound
 }
 
 func (nm *NotificationManager) SnoozeSound(minutes int) {
@@ -335,7 +481,116 @@ func (nm *NotificationManager) IsSnoozeActive() bool {
 	return true
 }
 
+// SetTemplates sets the title and message templates for incident
+// notifications. Empty templates restore the default text.
+func (nm *NotificationManager) SetTemplates(titleTemplate, messageTemplate string) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+	nm.config.TitleTemplate = titleTemplate
+	nm.config.MessageTemplate = messageTemplate
+	if nm.logger != nil {
+		nm.logger.Info(fmt.Sprintf("Notification templates set: title=%q message=%q", titleTemplate, messageTemplate))
+	}
+}
+
+// FormatIncident returns the notification title and message for an incident,
+// rendering the configured templates. Without templates the title is the
+// service summary and the message the incident title.
+func (nm *NotificationManager) FormatIncident(incident database.IncidentData) (string, string) {
+	nm.mu.RLock()
+	titleTemplate := nm.config.TitleTemplate
+	messageTemplate := nm.config.MessageTemplate
+	nm.mu.RUnlock()
+
+	replacer := strings.NewReplacer(
+		"{service}", incident.ServiceSummary,
+		"{title}", incident.Title,
+		"{urgency}", incident.Urgency,
+		"{number}", strconv.Itoa(incident.IncidentNumber),
+	)
+
+	title := incident.ServiceSummary
+	if titleTemplate != "" {
+		title = replacer.Replace(titleTemplate)
+	}
+	message := incident.Title
+	if messageTemplate != "" {
+		message = replacer.Replace(messageTemplate)
+	}
+	return title, message
+}
+
+// quietHoursLayout is the "HH:MM" format used for quiet hours boundaries
+const quietHoursLayout = "15:04"
+
+// SetQuietHours configures the daily window during which notification sounds
+// are muted. The window may span midnight, e.g. 22:00 to 07:00.
+func (nm *NotificationManager) SetQuietHours(start, end string, enabled bool) error {
+	if _, err := time.Parse(quietHoursLayout, start); err != nil {
+		return fmt.Errorf("invalid quiet hours start %q, expected HH:MM", start)
+	}
+	if _, err := time.Parse(quietHoursLayout, end); err != nil {
+		return fmt.Errorf("invalid quiet hours end %q, expected HH:MM", end)
+	}
+
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+	nm.config.QuietHoursStart = start
+	nm.config.QuietHoursEnd = end
+	nm.config.QuietHoursEnabled = enabled
+	if nm.logger != nil {
+		nm.logger.Info(fmt.Sprintf("Quiet hours set to %s-%s (enabled: %v)", start, end, enabled))
+	}
+	return nil
+}
+
+// IsQuietHoursActive reports whether the current local time falls inside the
+// configured quiet hours
+func (nm *NotificationManager) IsQuietHoursActive() bool {
+	nm.mu.RLock()
+	config := nm.config
+	nm.mu.RUnlock()
+
+	if !config.QuietHoursEnabled {
+		return false
+	}
+	return inQuietHours(time.Now(), config.QuietHoursStart, config.QuietHoursEnd)
+}
+
+// inQuietHours reports whether now falls in the [start, end) window. A window
+// whose end is earlier than its start spans midnight; equal bounds are empty.
+func inQuietHours(now time.Time, start, end string) bool {
+	startTime, err := time.Parse(quietHoursLayout, start)
+	if err != nil {
+		return false
+	}
+	endTime, err := time.Parse(quietHoursLayout, end)
+	if err != nil {
+		return false
+	}
+
+	minutes := now.Hour()*60 + now.Minute()
+	from := startTime.Hour()*60 + startTime.Minute()
+	to := endTime.Hour()*60 + endTime.Minute()
+
+	if from <= to {
+		return minutes >= from && minutes < to
+	}
+	return minutes >= from || minutes < to
+}
+
 func (nm *NotificationManager) SendNotification(serviceSummary, message, htmlURL, serviceName string) error {
+	nm.mu.RLock()
+	sound := nm.config.Sound
+	nm.mu.RUnlock()
+
+	return nm.SendNotificationWithSound("", serviceSummary, message, htmlURL, serviceName, sound)
+}
+
+// SendNotificationWithSound sends a notification that plays the given sound
+// instead of the global one. When incidentID is set and an ack callback is
+// registered, the notification offers an "Acknowledge" action where supported.
+func (nm *NotificationManager) SendNotificationWithSound(incidentID, serviceSummary, message, htmlURL, serviceName, sound string) error {
 	nm.mu.RLock()
 	config := nm.config
 	nm.mu.RUnlock()
@@ -350,39 +605,22 @@ func (nm *NotificationManager) SendNotification(serviceSummary, message, htmlURL
 		return nil
 	}
 
-	// Use terminal-notifier for macOS notifications with URL support
-	args := []string{
-		"-title", serviceSummary,
-		"-message", message,
-	}
-
-	// Add URL if provided - clicking notification will open the incident
-	if htmlURL != "" {
-		args = append(args, "-open", htmlURL)
-	}
-
-	cmd := exec.Command("terminal-notifier", args...)
-	err := cmd.Run()
-	if err != nil && nm.logger != nil {
-		// Fallback to osascript if terminal-notifier is not installed
-		fallbackCmd := exec.Command("osascript", "-e",
-			fmt.Sprintf(`display notification "%s" with title "%s"`, message, serviceSummary))
-		if fallbackErr := fallbackCmd.Run(); fallbackErr != nil {
-			nm.logger.Error(fmt.Sprintf("Failed to send notification: %v (fallback also failed: %v)", err, fallbackErr))
-			return fmt.Errorf("notification failed: %w", err)
-		}
+	// Show the visual notification using the platform's notifier
+	if err := nm.showNotification(incidentID, serviceSummary, message, htmlURL); err != nil {
+		return err
 	}
 
-	// Queue sound playback if not snoozed
-	if !nm.IsSnoozeActive() {
+	// Queue sound playback if not snoozed or in quiet hours; the visual
+	// notification above is still shown
+	if !nm.IsSnoozeActive() && !nm.IsQuietHoursActive() {
 		soundReq := SoundRequest{
 			Type:        "default",
 			ServiceName: serviceName,
 		}
 		
-		if config.Sound != "default" {
+		if sound != "default" {
 			soundReq.Type = "custom"
-			soundReq.SoundFile = config.Sound
+			soundReq.SoundFile = sound
 		}
 		
 		// Non-blocking send to queue
@@ -413,6 +651,186 @@ func (nm *NotificationManager) SendNotification(serviceSummary, message, htmlURL
 	return nil
 }
 
+// SendQuietNotification shows a low-priority visual notification: no sound,
+// no browser redirect and no acknowledge action
+func (nm *NotificationManager) SendQuietNotification(title, message, htmlURL string) error {
+	nm.mu.RLock()
+	enabled := nm.config.Enabled
+	nm.mu.RUnlock()
+
+	if !enabled {
+		return nil
+	}
+
+	if !nm.rateLimiter.Allow() {
+		nm.logger.Warn("Notification rate limited - too many notifications")
+		return nil
+	}
+
+	return nm.showNotification("", title, message, htmlURL)
+}
+
+// showNotification dispatches a visual notification to the platform notifier.
+// A missing notifier binary is logged and skipped so sound and redirects still work.
+func (nm *NotificationManager) showNotification(incidentID, title, message, htmlURL string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return nm.showDarwinNotification(incidentID, title, message, htmlURL)
+	case "linux":
+		return nm.showLinuxNotification(title, message)
+	case "windows":
+		return nm.showWindowsNotification(title, message)
+	default:
+		nm.logger.Warn(fmt.Sprintf("Visual notifications not supported on %s", runtime.GOOS))
+		return nil
+	}
+}
+
+// showDarwinNotification uses terminal-notifier, falling back to osascript
+func (nm *NotificationManager) showDarwinNotification(incidentID, serviceSummary, message, htmlURL string) error {
+	// Use terminal-notifier for macOS notifications with URL support
+	args := []string{
+		"-title", serviceSummary,
+		"-message", message,
+	}
+
+	// Add URL if provided - clicking notification will open the incident
+	if htmlURL != "" {
+		args = append(args, "-open", htmlURL)
+	}
+
+	nm.mu.RLock()
+	ackCallback := nm.ackCallback
+	nm.mu.RUnlock()
+
+	// With an action, terminal-notifier blocks until the user responds and
+	// prints the chosen action, so it runs in the background
+	if incidentID != "" && ackCallback != nil {
+		if _, err := exec.LookPath("terminal-notifier"); err == nil {
+			args = append(args, "-actions", notificationAckAction, "-timeout", "600")
+			go nm.awaitNotificationAction(incidentID, args, ackCallback)
+			return nil
+		}
+	}
+
+	cmd := exec.Command("terminal-notifier", args...)
+	err := cmd.Run()
+	if err != nil && nm.logger != nil {
+		// Fallback to osascript if terminal-notifier is not installed
+		fallbackCmd := exec.Command("osascript", "-e",
+			fmt.Sprintf(`display notification "%s" with title "%s"`, message, serviceSummary))
+		if fallbackErr := fallbackCmd.Run(); fallbackErr != nil {
+			nm.logger.Error(fmt.Sprintf("Failed to send notification: %v (fallback also failed: %v)", err, fallbackErr))
+			return fmt.Errorf("notification failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// notificationAckAction is the label of the acknowledge action button
+const notificationAckAction = "Acknowledge"
+
+// awaitNotificationAction runs terminal-notifier with an action and invokes the
+// ack callback if the user picks it
+func (nm *NotificationManager) awaitNotificationAction(incidentID string, args []string, ackCallback func(string)) {
+	output, err := exec.Command("terminal-notifier", args...).Output()
+	if err != nil {
+		nm.logger.Error(fmt.Sprintf("Failed to send notification: %v", err))
+		return
+	}
+
+	if strings.TrimSpace(string(output)) == notificationAckAction {
+		nm.logger.Info(fmt.Sprintf("Acknowledge action clicked for incident %s", incidentID))
+		ackCallback(incidentID)
+	}
+}
+
+// showLinuxNotification uses notify-send from libnotify
+func (nm *NotificationManager) showLinuxNotification(title, message string) error {
+	if _, err := exec.LookPath("notify-send"); err != nil {
+		nm.logger.Warn("notify-send not found, skipping visual notification")
+		return nil
+	}
+
+	cmd := exec.Command("notify-send", "--app-name=PagerOps", title, message)
+	if err := cmd.Run(); err != nil {
+		nm.logger.Error(fmt.Sprintf("Failed to send notification: %v", err))
+		return fmt.Errorf("notification failed: %w", err)
+	}
+	return nil
+}
+
+// showWindowsNotification shows a toast through the WinRT API via PowerShell
+func (nm *NotificationManager) showWindowsNotification(title, message string) error {
+	if _, err := exec.LookPath("powershell"); err != nil {
+		nm.logger.Warn("powershell not found, skipping visual notification")
+		return nil
+	}
+
+	script := fmt.Sprintf(`[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] > $null
+$template = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent([Windows.UI.Notifications.ToastTemplateType]::ToastText02)
+$text = $template.GetElementsByTagName('text')
+$text.Item(0).AppendChild($template.CreateTextNode('%s')) > $null
+$text.Item(1).AppendChild($template.CreateTextNode('%s')) > $null
+$toast = [Windows.UI.Notifications.ToastNotification]::new($template)
+[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier('PagerOps').Show($toast)`,
+		psQuote(title), psQuote(message))
+
+	cmd := exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", script)
+	if err := cmd.Run(); err != nil {
+		nm.logger.Error(fmt.Sprintf("Failed to send notification: %v", err))
+		return fmt.Errorf("notification failed: %w", err)
+	}
+	return nil
+}
+
+// psQuote escapes a value for use inside a single-quoted PowerShell string
+func psQuote(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
+
+// IsSupported reports whether a visual notifier is available on this platform
+func (nm *NotificationManager) IsSupported() bool {
+	var candidates []string
+	switch runtime.GOOS {
+	case "darwin":
+		candidates = []string{"terminal-notifier", "osascript"}
+	case "linux":
+		candidates = []string{"notify-send"}
+	case "windows":
+		candidates = []string{"powershell"}
+	}
+
+	for _, name := range candidates {
+		if _, err := exec.LookPath(name); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// BinaryAvailability reports whether each external command this platform
+// shells out to for notifications and sounds is on PATH
+func (nm *NotificationManager) BinaryAvailability() map[string]bool {
+	var names []string
+	switch runtime.GOOS {
+	case "darwin":
+		names = []string{"terminal-notifier", "osascript", "say", "afplay"}
+	case "linux":
+		names = []string{"notify-send", "paplay", "aplay", "espeak"}
+	case "windows":
+		names = []string{"powershell"}
+	}
+
+	available := make(map[string]bool, len(names))
+	for _, name := range names {
+		_, err := exec.LookPath(name)
+		available[name] = err == nil
+	}
+	return available
+}
+
 func (nm *NotificationManager) QueueBrowserRedirect(incidentID, htmlURL string) {
 	nm.mu.RLock()
 	enabled := nm.config.BrowserRedirect
@@ -436,13 +854,35 @@ func (nm *NotificationManager) QueueBrowserRedirect(incidentID, htmlURL string)
 	}
 }
 
-// executeDefaultSound uses the say command with the configured service name
+// executeDefaultSound speaks the service name with the platform's TTS engine:
+// say on macOS, espeak on Linux and System.Speech on Windows. Falls back to a
+// terminal beep when no TTS engine is available.
 func (nm *NotificationManager) executeDefaultSound(serviceName string) error {
 	if serviceName == "" {
 		serviceName = "New Incident"
 	}
 
-	cmd := exec.Command("say", serviceName)
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("say", serviceName)
+	case "linux":
+		if _, err := exec.LookPath("espeak"); err == nil {
+			cmd = exec.Command("espeak", serviceName)
+		}
+	case "windows":
+		if _, err := exec.LookPath("powershell"); err == nil {
+			script := fmt.Sprintf(`Add-Type -AssemblyName System.Speech
+(New-Object System.Speech.Synthesis.SpeechSynthesizer).Speak('%s')`, psQuote(serviceName))
+			cmd = exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", script)
+		}
+	}
+
+	if cmd == nil {
+		nm.logger.Debug(fmt.Sprintf("No text-to-speech engine on %s, using beep", runtime.GOOS))
+		return nm.beep()
+	}
+
 	err := cmd.Run()
 	if err != nil && nm.logger != nil {
 		nm.logger.Error(fmt.Sprintf("Failed to play default sound: %v", err))
@@ -451,7 +891,47 @@ func (nm *NotificationManager) executeDefaultSound(serviceName string) error {
 	return nil
 }
 
-// executeCustomSound uses afplay for custom sound files
+// beep plays the simplest sound available: the console beep on Windows and
+// the terminal bell elsewhere
+func (nm *NotificationManager) beep() error {
+	if runtime.GOOS == "windows" {
+		if _, err := exec.LookPath("powershell"); err == nil {
+			return exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", "[console]::beep(880,300)").Run()
+		}
+	}
+	_, err := fmt.Fprint(os.Stdout, "\a")
+	return err
+}
+
+// linuxSoundPlayers are tried in order to play a custom sound. The bundled
+// sounds are MP3, so decoders come first; paplay only handles MP3 when
+// libsndfile was built with it, and aplay is limited to WAV.
+var linuxSoundPlayers = []struct {
+	name    string
+	args    []string
+	wavOnly bool
+}{
+	{name: "ffplay", args: []string{"-nodisp", "-autoexit", "-loglevel", "quiet"}},
+	{name: "mpg123", args: []string{"-q"}},
+	{name: "paplay"},
+	{name: "aplay", wavOnly: true},
+}
+
+// windowsPlaySoundScript plays a file with WPF's MediaPlayer, which decodes
+// MP3 unlike System.Media.SoundPlayer. MediaPlayer plays asynchronously, so
+// the script waits for the media to load (up to 5s) and then for its length.
+const windowsPlaySoundScript = `Add-Type -AssemblyName PresentationCore
+$p = New-Object System.Windows.Media.MediaPlayer
+$p.Open([uri]'%s')
+for ($i = 0; $i -lt 50 -and -not $p.NaturalDuration.HasTimeSpan; $i++) { Start-Sleep -Milliseconds 100 }
+if (-not $p.NaturalDuration.HasTimeSpan) { exit 1 }
+$p.Play()
+Start-Sleep -Milliseconds ([int]$p.NaturalDuration.TimeSpan.TotalMilliseconds + 100)
+$p.Close()`
+
+// executeCustomSound plays a sound file with afplay on macOS, the first
+// available player in linuxSoundPlayers on Linux, and WPF's MediaPlayer on
+// Windows
 func (nm *NotificationManager) executeCustomSound(soundFile string) error {
 	soundPath := filepath.Join(".", "assets", "sounds", soundFile)
 
@@ -461,8 +941,36 @@ func (nm *NotificationManager) executeCustomSound(soundFile string) error {
 		return err
 	}
 
-	// Use afplay for macOS
-	cmd := exec.Command("afplay", soundPath)
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("afplay", soundPath)
+	case "linux":
+		isWAV := strings.EqualFold(filepath.Ext(soundPath), ".wav")
+		for _, player := range linuxSoundPlayers {
+			if player.wavOnly && !isWAV {
+				continue
+			}
+			if _, err := exec.LookPath(player.name); err == nil {
+				cmd = exec.Command(player.name, append(append([]string{}, player.args...), soundPath)...)
+				break
+			}
+		}
+	case "windows":
+		// MediaPlayer needs an absolute path to build the URI
+		absPath, err := filepath.Abs(soundPath)
+		if err != nil {
+			absPath = soundPath
+		}
+		script := fmt.Sprintf(windowsPlaySoundScript, psQuote(absPath))
+		cmd = exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", script)
+	}
+
+	if cmd == nil {
+		nm.logger.Warn(fmt.Sprintf("No audio player found on %s, using beep", runtime.GOOS))
+		return nm.beep()
+	}
+
 	err := cmd.Run()
 	if err != nil && nm.logger != nil {
 		nm.logger.Error(fmt.Sprintf("Failed to play custom sound %s: %v", soundPath, err))
@@ -505,6 +1013,43 @@ func (nm *NotificationManager) GetAvailableSounds() ([]string, error) {
 	return sounds, nil
 }
 
+// notifierInstallHints says how to install each platform's notifier binary
+var notifierInstallHints = map[string]string{
+	"terminal-notifier": "install it with: brew install terminal-notifier",
+	"notify-send":       "install libnotify (e.g. apt install libnotify-bin)",
+	"powershell":        "PowerShell is required for Windows toast notifications",
+}
+
+// TestNotification shows a sample visual notification, bypassing the enabled
+// flag, rate limiter, snooze and quiet hours, and reports a descriptive error
+// when the platform's notifier binary is missing
+func (nm *NotificationManager) TestNotification() error {
+	var binary string
+	switch runtime.GOOS {
+	case "darwin":
+		binary = "terminal-notifier"
+	case "linux":
+		binary = "notify-send"
+	case "windows":
+		binary = "powershell"
+	default:
+		return fmt.Errorf("visual notifications are not supported on %s", runtime.GOOS)
+	}
+
+	if _, err := exec.LookPath(binary); err != nil {
+		return fmt.Errorf("%s not found on PATH; %s", binary, notifierInstallHints[binary])
+	}
+
+	title := "PagerOps test notification"
+	message := "Notifications are working"
+	if err := nm.showNotification("", title, message, ""); err != nil {
+		return fmt.Errorf("test notification failed: %w", err)
+	}
+
+	nm.logger.Info(fmt.Sprintf("Test notification sent via %s", binary))
+	return nil
+}
+
 func (nm *NotificationManager) TestSound() error {
 	nm.mu.RLock()
 	sound := nm.config.Sound
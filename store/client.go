@@ -3,7 +3,10 @@ package store
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"pager-ops/database"
+	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -26,11 +29,25 @@ type APIResponse struct {
 	Error error
 }
 
+// requestPriority orders queued requests. High priority requests are sent
+// before any waiting normal ones.
+type requestPriority int
+
+const (
+	// priorityNormal is for background work such as polling
+	priorityNormal requestPriority = iota
+	// priorityHigh is for user-initiated actions that the UI is waiting on
+	priorityHigh
+)
+
 // APIQueue manages rate-limited API calls
 type APIQueue struct {
-	requestChan chan *APIRequest
-	stopChan    chan struct{}
-	wg          sync.WaitGroup
+	requestChan      chan *APIRequest
+	highPriorityChan chan *APIRequest
+	stopChan         chan struct{}
+	done             chan struct{} // closed once the worker has exited
+	shutdownOnce     sync.Once
+	wg               sync.WaitGroup
 
 	// Rate limiting
 	maxCallsPerMinute int
@@ -49,20 +66,83 @@ type Client struct {
 	apiKey   string // retained for raw API calls not covered by go-pagerduty (e.g. incident custom fields)
 	apiQueue *APIQueue
 	logger   func(string)
+
+	maxOpenResults int32 // atomic; cap on open incidents fetched per poll
+
+	httpClient       pagerduty.HTTPClient // shared by go-pagerduty and raw requests; watches for 429s
+	rateLimitedUntil int64                // unix nanos; the queue holds off until then after a 429
+
+	standardTimeout int64 // nanos; how long list fetches may take
+	resolvedTimeout int64 // nanos; how long resolved incident fetches may take
+}
+
+// Default request timeouts. Resolved fetches page through far more incidents,
+// so they get longer.
+const (
+	DefaultStandardTimeout = 60 * time.Second
+	DefaultResolvedTimeout = 120 * time.Second
+)
+
+// PagerDutyClient is the API surface the app uses. It is satisfied by the
+// queue-backed Client and by MockClient for demo mode.
+type PagerDutyClient interface {
+	SetLogger(logger func(string))
+	SetMaxCallsPerMinute(maxCalls int)
+	MaxCallsPerMinute() int
+	SetMaxOpenResults(maxResults int)
+	SetTimeouts(standard, resolved time.Duration)
+	Shutdown()
+	GetAPIStats() (totalCalls int64, failedCalls int64, pendingRequests int, rateLimited bool)
+	IsRateLimited() bool
+
+	GetCurrentUser() (*pagerduty.User, error)
+	FetchOpenIncidents(serviceIDs []string, userID string) ([]database.IncidentData, error)
+	FetchResolvedIncidents(serviceIDs []string) ([]database.IncidentData, error)
+	FetchIncidentsWithPagination(opts FetchOptions, pageSize uint) ([]database.IncidentData, error)
+	FetchResolvedIncidentsConcurrent(serviceIDs []string, since, until time.Time, concurrency int) ([]database.IncidentData, error)
+	FetchIncidentsWithOptions(opts FetchOptions) ([]database.IncidentData, error)
+	GetIncidentAlerts(incidentID string) ([]IncidentAlert, error)
+	GetIncidentNotes(incidentID string) ([]IncidentNote, error)
+	GetIncidentResponders(incidentID string) ([]Responder, error)
+	GetIncidentLogEntries(incidentID string) ([]LogEntry, error)
+	ListPriorities() ([]Priority, error)
+	ListUsers(query string) ([]UserSummary, error)
+	ListTeams() ([]Team, error)
+	GetOnCallsForService(serviceID string) ([]OnCallEntry, error)
+
+	AcknowledgeIncident(incidentID, userEmail string) error
+	ResolveIncident(incidentID, userEmail string) error
+	ReopenIncident(incidentID, userEmail string) error
+	SetIncidentPriority(incidentID, priorityID, userEmail string) error
+	SnoozeIncident(incidentID string, duration time.Duration, userEmail string) error
+	CreateIncidentNote(incidentID string, noteContent string) error
+	AddResponders(incidentID string, userIDs []string, message, requesterID, userEmail string) error
+
+	GetIncidentCustomFields(incidentID string) ([]CustomField, error)
+	GetIncidentCustomFieldValues(incidentID string) ([]CustomFieldValue, error)
+	SetIncidentCustomFieldValue(incidentID, fieldID string, value interface{}, fromEmail string) error
 }
 
 // NewClient creates a new PagerDuty client with API queue
 func NewClient(apiKey string) (*Client, error) {
+	return newClient(apiKey)
+}
+
+// newClient is NewClient with go-pagerduty options, so tests can point the
+// client at a stub API endpoint
+func newClient(apiKey string, options ...pagerduty.ClientOptions) (*Client, error) {
 	if apiKey == "" {
 		return nil, fmt.Errorf("API key is required")
 	}
 
-	pdClient := pagerduty.NewClient(apiKey)
+	pdClient := pagerduty.NewClient(apiKey, options...)
 
 	// Initialize API queue
 	queue := &APIQueue{
 		requestChan:       make(chan *APIRequest, 100), // Buffer for 100 requests
+		highPriorityChan:  make(chan *APIRequest, 20),
 		stopChan:          make(chan struct{}),
+		done:              make(chan struct{}),
 		maxCallsPerMinute: 600, // Conservative: 600 calls/min (PagerDuty allows 960)
 		callTimes:         make([]time.Time, 0),
 	}
@@ -72,7 +152,18 @@ func NewClient(apiKey string) (*Client, error) {
 		apiKey:   apiKey,
 		apiQueue: queue,
 		logger:   func(msg string) { fmt.Println(msg) }, // Default logger
+
+		maxOpenResults: int32(DefaultMaxResults),
+
+		standardTimeout: int64(DefaultStandardTimeout),
+		resolvedTimeout: int64(DefaultResolvedTimeout),
+	}
+
+	client.httpClient = &rateLimitHTTPClient{
+		next:      pdClient.HTTPClient,
+		onLimited: client.markRateLimited,
 	}
+	pdClient.HTTPClient = client.httpClient
 
 	// Start the API queue worker
 	queue.wg.Add(1)
@@ -86,11 +177,59 @@ func (c *Client) SetLogger(logger func(string)) {
 	c.logger = logger
 }
 
-// Shutdown gracefully stops the API queue
+// SetMaxOpenResults sets how many open incidents FetchOpenIncidents returns per
+// service or user query. Values <= 0 restore DefaultMaxResults.
+func (c *Client) SetMaxOpenResults(maxResults int) {
+	atomic.StoreInt32(&c.maxOpenResults, int32(maxResultsOrDefault(maxResults)))
+}
+
+// SetTimeouts sets how long list fetches and resolved incident fetches may
+// take. Values <= 0 restore the defaults.
+func (c *Client) SetTimeouts(standard, resolved time.Duration) {
+	if standard <= 0 {
+		standard = DefaultStandardTimeout
+	}
+	if resolved <= 0 {
+		resolved = DefaultResolvedTimeout
+	}
+	atomic.StoreInt64(&c.standardTimeout, int64(standard))
+	atomic.StoreInt64(&c.resolvedTimeout, int64(resolved))
+}
+
+// timeoutFor returns the resolved timeout when statuses include "resolved",
+// and the standard timeout otherwise
+func (c *Client) timeoutFor(statuses []string) time.Duration {
+	for _, status := range statuses {
+		if status == "resolved" {
+			return time.Duration(atomic.LoadInt64(&c.resolvedTimeout))
+		}
+	}
+	return time.Duration(atomic.LoadInt64(&c.standardTimeout))
+}
+
+// SetMaxCallsPerMinute updates the queue's rate limit at runtime
+func (c *Client) SetMaxCallsPerMinute(maxCalls int) {
+	c.apiQueue.mu.Lock()
+	defer c.apiQueue.mu.Unlock()
+	c.apiQueue.maxCallsPerMinute = maxCalls
+}
+
+// MaxCallsPerMinute returns the queue's current rate limit
+func (c *Client) MaxCallsPerMinute() int {
+	c.apiQueue.mu.Lock()
+	defer c.apiQueue.mu.Unlock()
+	return c.apiQueue.maxCallsPerMinute
+}
+
+// Shutdown gracefully stops the API queue. It is safe to call more than once.
+// The request channels are left open so late callers get an error instead of
+// a send on a closed channel.
 func (c *Client) Shutdown() {
-	close(c.apiQueue.stopChan)
-	c.apiQueue.wg.Wait()
-	close(c.apiQueue.requestChan)
+	c.apiQueue.shutdownOnce.Do(func() {
+		close(c.apiQueue.stopChan)
+		c.apiQueue.wg.Wait()
+		close(c.apiQueue.done)
+	})
 }
 
 // processAPIQueue is the main worker that processes API requests
@@ -101,15 +240,32 @@ func (c *Client) processAPIQueue() {
 	defer ticker.Stop()
 
 	for {
+		// Drain user-initiated requests before looking at background ones
+		select {
+		case req := <-c.apiQueue.highPriorityChan:
+			c.waitForRateLimit()
+			c.executeAPICall(req)
+			continue
+		default:
+		}
+
 		select {
 		case <-c.apiQueue.stopChan:
 			// Process remaining requests before shutdown
+			for len(c.apiQueue.highPriorityChan) > 0 {
+				req := <-c.apiQueue.highPriorityChan
+				c.executeAPICall(req)
+			}
 			for len(c.apiQueue.requestChan) > 0 {
 				req := <-c.apiQueue.requestChan
 				c.executeAPICall(req)
 			}
 			return
 
+		case req := <-c.apiQueue.highPriorityChan:
+			c.waitForRateLimit()
+			c.executeAPICall(req)
+
 		case req := <-c.apiQueue.requestChan:
 			// Wait if rate limit would be exceeded
 			c.waitForRateLimit()
@@ -122,8 +278,19 @@ func (c *Client) processAPIQueue() {
 	}
 }
 
-// waitForRateLimit ensures we don't exceed rate limits
+// waitForRateLimit ensures we don't exceed rate limits. Waits end early on
+// shutdown so the queue can drain.
 func (c *Client) waitForRateLimit() {
+	// Honor any pause PagerDuty requested with a 429 before counting calls
+	if pause := c.rateLimitPause(); pause > 0 {
+		c.logger(fmt.Sprintf("Rate limited by PagerDuty, waiting %v", pause.Round(time.Second)))
+		select {
+		case <-time.After(pause):
+		case <-c.apiQueue.stopChan:
+			return
+		}
+	}
+
 	c.apiQueue.mu.Lock()
 	defer c.apiQueue.mu.Unlock()
 
@@ -145,7 +312,11 @@ func (c *Client) waitForRateLimit() {
 		waitDuration := oldestCall.Add(1 * time.Minute).Sub(now)
 		if waitDuration > 0 {
 			c.logger(fmt.Sprintf("Rate limit reached, waiting %v", waitDuration))
-			time.Sleep(waitDuration)
+			select {
+			case <-time.After(waitDuration):
+			case <-c.apiQueue.stopChan:
+				return
+			}
 		}
 	}
 
@@ -198,14 +369,69 @@ func (c *Client) executeAPICall(req *APIRequest) {
 		incidentID := req.Options.(string)
 		result, err = c.pd.ListIncidentNotesWithContext(req.Context, incidentID)
 
+	case "GetIncident":
+		incidentID := req.Options.(string)
+		result, err = c.pd.GetIncidentWithContext(req.Context, incidentID)
+
+	case "ListIncidentLogEntries":
+		incidentID := req.Options.(string)
+		result, err = c.pd.ListIncidentLogEntriesWithContext(req.Context, incidentID, pagerduty.ListIncidentLogEntriesOptions{
+			Limit: maxLogEntries,
+		})
+
 	case "ManageIncidents":
 		opts := req.Options.(ManageIncidentsRequest)
-		result, err = c.pd.ManageIncidentsWithContext(req.Context, opts.From, []pagerduty.ManageIncidentsOptions{
-			{
-				ID:     opts.IncidentID,
-				Type:   "incident",
-				Status: opts.Status,
-			},
+		manageOpts := pagerduty.ManageIncidentsOptions{
+			ID:     opts.IncidentID,
+			Type:   "incident",
+			Status: opts.Status,
+		}
+		if opts.PriorityID != "" {
+			manageOpts.Priority = &pagerduty.APIReference{
+				ID:   opts.PriorityID,
+				Type: "priority_reference",
+			}
+		}
+		result, err = c.pd.ManageIncidentsWithContext(req.Context, opts.From, []pagerduty.ManageIncidentsOptions{manageOpts})
+
+	case "ListUsers":
+		opts := req.Options.(pagerduty.ListUsersOptions)
+		result, err = c.pd.ListUsersWithContext(req.Context, opts)
+
+	case "ListTeams":
+		opts := req.Options.(pagerduty.ListTeamOptions)
+		result, err = c.pd.ListTeamsWithContext(req.Context, opts)
+
+	case "ListPriorities":
+		result, err = c.pd.ListPrioritiesWithContext(req.Context, pagerduty.ListPrioritiesOptions{})
+
+	case "GetService":
+		serviceID := req.Options.(string)
+		result, err = c.pd.GetServiceWithContext(req.Context, serviceID, &pagerduty.GetServiceOptions{})
+
+	case "ListOnCalls":
+		opts := req.Options.(pagerduty.ListOnCallOptions)
+		result, err = c.pd.ListOnCallsWithContext(req.Context, opts)
+
+	case "SnoozeIncident":
+		opts := req.Options.(SnoozeIncidentRequest)
+		result, err = c.postIncidentSnooze(req.Context, opts)
+
+	case "ResponderRequest":
+		opts := req.Options.(ResponderRequest)
+		targets := make([]pagerduty.ResponderRequestTargetWrapper, 0, len(opts.UserIDs))
+		for _, userID := range opts.UserIDs {
+			targets = append(targets, pagerduty.ResponderRequestTargetWrapper{
+				Target: pagerduty.ResponderRequestTarget{
+					APIObject: pagerduty.APIObject{ID: userID, Type: "user_reference"},
+				},
+			})
+		}
+		result, err = c.pd.ResponderRequestWithContext(req.Context, opts.IncidentID, pagerduty.ResponderRequestOptions{
+			From:        opts.From,
+			Message:     opts.Message,
+			RequesterID: opts.RequesterID,
+			Targets:     targets,
 		})
 
 	case "CreateIncidentNote":
@@ -235,6 +461,12 @@ func (c *Client) executeAPICall(req *APIRequest) {
 		// Increment failure counter atomically
 		atomic.AddInt64(&c.apiQueue.failedCalls, 1)
 		c.logger(fmt.Sprintf("API call failed: %s - %v", req.Type, err))
+
+		// The HTTP client normally records the pause with Retry-After; this
+		// covers responses that reached us without passing through it
+		if isRateLimitError(err) && !c.IsRateLimited() {
+			c.markRateLimited(defaultRateLimitPause)
+		}
 	}
 
 	// Send response
@@ -246,7 +478,7 @@ func (c *Client) executeAPICall(req *APIRequest) {
 }
 
 // queueRequest adds a request to the queue and waits for response
-func (c *Client) queueRequest(reqType string, ctx context.Context, options interface{}) (interface{}, error) {
+func (c *Client) queueRequest(reqType string, ctx context.Context, options interface{}, priority requestPriority) (interface{}, error) {
 	req := &APIRequest{
 		Type:       reqType,
 		Context:    ctx,
@@ -254,42 +486,54 @@ func (c *Client) queueRequest(reqType string, ctx context.Context, options inter
 		ResultChan: make(chan APIResponse, 1),
 	}
 
+	queue := c.apiQueue.requestChan
+	if priority == priorityHigh {
+		queue = c.apiQueue.highPriorityChan
+	}
+
+	// Refuse new work once the queue is stopping
+	select {
+	case <-c.apiQueue.stopChan:
+		return nil, fmt.Errorf("client is shut down, cannot queue %s request", reqType)
+	default:
+	}
+
 	// Send request to queue with longer timeout
 	select {
-	case c.apiQueue.requestChan <- req:
+	case queue <- req:
 	case <-ctx.Done():
 		return nil, fmt.Errorf("context cancelled while queueing %s request", reqType)
 	case <-time.After(30 * time.Second):
 		// Log queue stats for debugging - USE ALL VARIABLES
-		total, failed, pending := c.GetAPIStats()
-		c.logger(fmt.Sprintf("Queue timeout: type=%s, pending=%d, total=%d, failed=%d",
-			reqType, pending, total, failed))
+		total, failed, pending, rateLimited := c.GetAPIStats()
+		c.logger(fmt.Sprintf("Queue timeout: type=%s, pending=%d, total=%d, failed=%d, rate_limited=%v",
+			reqType, pending, total, failed, rateLimited))
 		return nil, fmt.Errorf("timeout queueing %s request (queue may be full)", reqType)
 	}
 
 	// Wait for response with extended timeout for resolved incidents
-	timeout := 60 * time.Second
-	if strings.Contains(reqType, "ListIncidents") {
-		// Check if it's a resolved incidents fetch (has "resolved" in statuses)
-		if opts, ok := options.(pagerduty.ListIncidentsOptions); ok {
-			for _, status := range opts.Statuses {
-				if status == "resolved" {
-					timeout = 90 * time.Second // Longer timeout for resolved
-					break
-				}
-			}
-		}
+	timeout := c.timeoutFor(nil)
+	if opts, ok := options.(pagerduty.ListIncidentsOptions); ok {
+		timeout = c.timeoutFor(opts.Statuses)
 	}
 
 	select {
 	case resp := <-req.ResultChan:
 		return resp.Data, resp.Error
+	case <-c.apiQueue.done:
+		// The worker may have answered just before exiting
+		select {
+		case resp := <-req.ResultChan:
+			return resp.Data, resp.Error
+		default:
+		}
+		return nil, fmt.Errorf("client shut down before %s response", reqType)
 	case <-ctx.Done():
 		return nil, fmt.Errorf("context cancelled waiting for %s response", reqType)
 	case <-time.After(timeout):
-		total, failed, pending := c.GetAPIStats()
-		c.logger(fmt.Sprintf("Response timeout: type=%s, timeout=%v, pending=%d, total=%d, failed=%d",
-			reqType, timeout, pending, total, failed))
+		total, failed, pending, rateLimited := c.GetAPIStats()
+		c.logger(fmt.Sprintf("Response timeout: type=%s, timeout=%v, pending=%d, total=%d, failed=%d, rate_limited=%v",
+			reqType, timeout, pending, total, failed, rateLimited))
 		return nil, fmt.Errorf("timeout waiting for %s API response after %v", reqType, timeout)
 	}
 }
@@ -300,7 +544,7 @@ func (c *Client) GetCurrentUser() (*pagerduty.User, error) {
 	defer cancel()
 
 	options := pagerduty.GetCurrentUserOptions{}
-	result, err := c.queueRequest("GetCurrentUser", ctx, options)
+	result, err := c.queueRequest("GetCurrentUser", ctx, options, priorityNormal)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current user: %w", err)
 	}
@@ -316,11 +560,25 @@ func (c *Client) GetCurrentUser() (*pagerduty.User, error) {
 // FetchOptions provides flexible options
 type FetchOptions struct {
 	ServiceIDs []string
+	TeamIDs    []string // incidents of services owned by these teams; empty means any team
 	Statuses   []string
 	Since      time.Time
 	Until      time.Time
 	UserID     string
 	Limit      uint
+	MaxResults int // total cap across pages; 0 means DefaultMaxResults
+}
+
+// DefaultMaxResults is the number of incidents a paginated fetch returns when
+// no MaxResults is given
+const DefaultMaxResults = 100
+
+// maxResultsOrDefault returns n, or DefaultMaxResults if n is not positive
+func maxResultsOrDefault(n int) int {
+	if n <= 0 {
+		return DefaultMaxResults
+	}
+	return n
 }
 
 // FetchOpenIncidents fetches open incidents with rate limiting
@@ -346,7 +604,7 @@ func (c *Client) FetchOpenIncidents(serviceIDs []string, userID string) ([]datab
 	// Fetch incidents filtered by services (no user filter)
 	if len(serviceIDs) > 0 {
 		serviceIncidents, err := c.fetchIncidentsByServices(
-			serviceIDs, []string{"triggered", "acknowledged"})
+			serviceIDs, []string{"triggered", "acknowledged"}, int(atomic.LoadInt32(&c.maxOpenResults)))
 		if err != nil {
 			return nil, err
 		}
@@ -356,7 +614,7 @@ func (c *Client) FetchOpenIncidents(serviceIDs []string, userID string) ([]datab
 	// Fetch incidents assigned to current user (all services)
 	if userID != "" {
 		userIncidents, err := c.fetchIncidentsByUser(
-			userID, []string{"triggered", "acknowledged"})
+			userID, []string{"triggered", "acknowledged"}, int(atomic.LoadInt32(&c.maxOpenResults)))
 		if err != nil {
 			return nil, err
 		}
@@ -368,8 +626,8 @@ func (c *Client) FetchOpenIncidents(serviceIDs []string, userID string) ([]datab
 }
 
 // fetchIncidentsByServices fetches incidents by service IDs through queue
-func (c *Client) fetchIncidentsByServices(serviceIDs []string, statuses []string) ([]database.IncidentData, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+func (c *Client) fetchIncidentsByServices(serviceIDs []string, statuses []string, maxResults int) ([]database.IncidentData, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeoutFor(statuses))
 	defer cancel()
 
 	opts := pagerduty.ListIncidentsOptions{
@@ -381,12 +639,12 @@ func (c *Client) fetchIncidentsByServices(serviceIDs []string, statuses []string
 
 	var allIncidents []database.IncidentData
 	offset := uint(0)
-	maxPages := 2 // Limit to 100 incidents total
+	maxResults = maxResultsOrDefault(maxResults)
 
-	for page := 0; page < maxPages; page++ {
+	for {
 		opts.Offset = offset
 
-		result, err := c.queueRequest("ListIncidents", ctx, opts)
+		result, err := c.queueRequest("ListIncidents", ctx, opts, priorityNormal)
 		if err != nil {
 			return allIncidents, err // Return what we have
 		}
@@ -401,18 +659,22 @@ func (c *Client) fetchIncidentsByServices(serviceIDs []string, statuses []string
 			allIncidents = append(allIncidents, incident)
 		}
 
-		if !resp.More || len(allIncidents) >= 100 {
+		if !resp.More || len(resp.Incidents) == 0 || len(allIncidents) >= maxResults {
 			break
 		}
 		offset += opts.Limit
 	}
 
+	if len(allIncidents) > maxResults {
+		allIncidents = allIncidents[:maxResults]
+	}
+
 	return allIncidents, nil
 }
 
 // fetchIncidentsByUser fetches incidents by user ID through queue
-func (c *Client) fetchIncidentsByUser(userID string, statuses []string) ([]database.IncidentData, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+func (c *Client) fetchIncidentsByUser(userID string, statuses []string, maxResults int) ([]database.IncidentData, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeoutFor(statuses))
 	defer cancel()
 
 	opts := pagerduty.ListIncidentsOptions{
@@ -424,12 +686,12 @@ func (c *Client) fetchIncidentsByUser(userID string, statuses []string) ([]datab
 
 	var allIncidents []database.IncidentData
 	offset := uint(0)
-	maxPages := 2 // Limit to 100 incidents total
+	maxResults = maxResultsOrDefault(maxResults)
 
-	for page := 0; page < maxPages; page++ {
+	for {
 		opts.Offset = offset
 
-		result, err := c.queueRequest("ListIncidents", ctx, opts)
+		result, err := c.queueRequest("ListIncidents", ctx, opts, priorityNormal)
 		if err != nil {
 			return allIncidents, err // Return what we have
 		}
@@ -444,18 +706,22 @@ func (c *Client) fetchIncidentsByUser(userID string, statuses []string) ([]datab
 			allIncidents = append(allIncidents, incident)
 		}
 
-		if !resp.More || len(allIncidents) >= 100 {
+		if !resp.More || len(resp.Incidents) == 0 || len(allIncidents) >= maxResults {
 			break
 		}
 		offset += opts.Limit
 	}
 
+	if len(allIncidents) > maxResults {
+		allIncidents = allIncidents[:maxResults]
+	}
+
 	return allIncidents, nil
 }
 
 // FetchResolvedIncidents fetches resolved incidents through queue
 func (c *Client) FetchResolvedIncidents(serviceIDs []string) ([]database.IncidentData, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeoutFor([]string{"resolved"}))
 	defer cancel()
 
 	until := time.Now()
@@ -477,7 +743,7 @@ func (c *Client) FetchResolvedIncidents(serviceIDs []string) ([]database.Inciden
 	for page := 0; page < maxPages; page++ {
 		opts.Offset = offset
 
-		result, err := c.queueRequest("ListIncidents", ctx, opts)
+		result, err := c.queueRequest("ListIncidents", ctx, opts, priorityNormal)
 		if err != nil {
 			return allIncidents, err
 		}
@@ -503,15 +769,7 @@ func (c *Client) FetchResolvedIncidents(serviceIDs []string) ([]database.Inciden
 
 // FetchIncidentsWithPagination for controlled pagination through queue
 func (c *Client) FetchIncidentsWithPagination(opts FetchOptions, pageSize uint) ([]database.IncidentData, error) {
-	timeout := 60 * time.Second
-	for _, status := range opts.Statuses {
-		if status == "resolved" {
-			timeout = 120 * time.Second
-			break
-		}
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeoutFor(opts.Statuses))
 	defer cancel()
 
 	if pageSize == 0 {
@@ -521,6 +779,7 @@ func (c *Client) FetchIncidentsWithPagination(opts FetchOptions, pageSize uint)
 	pdOpts := pagerduty.ListIncidentsOptions{
 		Statuses:   opts.Statuses,
 		ServiceIDs: opts.ServiceIDs,
+		TeamIDs:    opts.TeamIDs,
 		Limit:      pageSize,
 		SortBy:     "created_at:desc",
 	}
@@ -537,12 +796,12 @@ func (c *Client) FetchIncidentsWithPagination(opts FetchOptions, pageSize uint)
 
 	var allIncidents []database.IncidentData
 	offset := uint(0)
-	maxPages := 2
+	maxResults := maxResultsOrDefault(opts.MaxResults)
 
-	for page := 0; page < maxPages; page++ {
+	for page := 0; ; page++ {
 		pdOpts.Offset = offset
 
-		result, err := c.queueRequest("ListIncidents", ctx, pdOpts)
+		result, err := c.queueRequest("ListIncidents", ctx, pdOpts, priorityNormal)
 		if err != nil {
 			return allIncidents, fmt.Errorf("failed to fetch page %d: %w", page, err)
 		}
@@ -557,23 +816,85 @@ func (c *Client) FetchIncidentsWithPagination(opts FetchOptions, pageSize uint)
 			allIncidents = append(allIncidents, incident)
 		}
 
-		if !resp.More || len(allIncidents) >= 100 {
+		if !resp.More || len(resp.Incidents) == 0 || len(allIncidents) >= maxResults {
 			break
 		}
 		offset += pageSize
 	}
 
+	if len(allIncidents) > maxResults {
+		allIncidents = allIncidents[:maxResults]
+	}
+
 	return allIncidents, nil
 }
 
+// FetchResolvedIncidentsConcurrent fetches resolved incidents one service at a
+// time with up to `concurrency` fetches in flight, so each service gets its own
+// pagination budget instead of sharing one. All requests still go through the
+// rate-limited queue. Results are merged and deduplicated; an error is returned
+// only if every service failed.
+func (c *Client) FetchResolvedIncidentsConcurrent(serviceIDs []string, since, until time.Time, concurrency int) ([]database.IncidentData, error) {
+	if len(serviceIDs) == 0 {
+		return []database.IncidentData{}, nil
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	var (
+		mu           sync.Mutex
+		wg           sync.WaitGroup
+		allIncidents []database.IncidentData
+		failures     int
+		lastErr      error
+	)
+	sem := make(chan struct{}, concurrency)
+
+	for _, serviceID := range serviceIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(serviceID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			opts := FetchOptions{
+				ServiceIDs: []string{serviceID},
+				Statuses:   []string{"resolved"},
+				Since:      since,
+				Until:      until,
+			}
+			incidents, err := c.FetchIncidentsWithPagination(opts, 50)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures++
+				lastErr = err
+				c.logger(fmt.Sprintf("Failed to fetch resolved incidents for service %s: %v", serviceID, err))
+			}
+			// Keep partial pages even on error
+			allIncidents = append(allIncidents, incidents...)
+		}(serviceID)
+	}
+	wg.Wait()
+
+	if failures == len(serviceIDs) {
+		return nil, fmt.Errorf("failed to fetch resolved incidents for all %d services: %w", failures, lastErr)
+	}
+
+	return deduplicateIncidents(allIncidents), nil
+}
+
 // FetchIncidentsWithOptions for flexible incident fetching through queue
 func (c *Client) FetchIncidentsWithOptions(opts FetchOptions) ([]database.IncidentData, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeoutFor(opts.Statuses))
 	defer cancel()
 
 	pdOpts := pagerduty.ListIncidentsOptions{
 		Statuses:   opts.Statuses,
 		ServiceIDs: opts.ServiceIDs,
+		TeamIDs:    opts.TeamIDs,
 		Limit:      100,
 		SortBy:     "created_at:desc",
 	}
@@ -597,7 +918,7 @@ func (c *Client) FetchIncidentsWithOptions(opts FetchOptions) ([]database.Incide
 	for {
 		pdOpts.Offset = offset
 
-		result, err := c.queueRequest("ListIncidents", ctx, pdOpts)
+		result, err := c.queueRequest("ListIncidents", ctx, pdOpts, priorityNormal)
 		if err != nil {
 			return nil, fmt.Errorf("failed to list incidents: %w", err)
 		}
@@ -626,7 +947,7 @@ func (c *Client) GetIncidentAlerts(incidentID string) ([]IncidentAlert, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	result, err := c.queueRequest("ListIncidentAlerts", ctx, incidentID)
+	result, err := c.queueRequest("ListIncidentAlerts", ctx, incidentID, priorityNormal)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch incident alerts: %w", err)
 	}
@@ -681,6 +1002,10 @@ func (c *Client) GetIncidentAlerts(incidentID string) ([]IncidentAlert, error) {
 			if convertedAlert.Description == "" {
 				convertedAlert.Description = extractDescription(alert.Body["details"])
 			}
+
+			// Pick up URLs from the rest of the body (e.g. a dashboard link in
+			// custom_details) for integrations that don't use CEF contexts
+			convertedAlert.Links = append(convertedAlert.Links, extractBodyLinks(alert.Body, convertedAlert.Links)...)
 		}
 
 		alerts = append(alerts, convertedAlert)
@@ -694,7 +1019,7 @@ func (c *Client) GetIncidentNotes(incidentID string) ([]IncidentNote, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	result, err := c.queueRequest("ListIncidentNotes", ctx, incidentID)
+	result, err := c.queueRequest("ListIncidentNotes", ctx, incidentID, priorityNormal)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch incident notes: %w", err)
 	}
@@ -724,6 +1049,245 @@ func (c *Client) GetIncidentNotes(incidentID string) ([]IncidentNote, error) {
 	return notes, nil
 }
 
+// GetIncidentResponders fetches the users asked to join an incident through
+// responder requests, and whether they accepted, through queue
+func (c *Client) GetIncidentResponders(incidentID string) ([]Responder, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	result, err := c.queueRequest("GetIncident", ctx, incidentID, priorityNormal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch incident responders: %w", err)
+	}
+
+	incident, ok := result.(*pagerduty.Incident)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type for incident")
+	}
+
+	responders := make([]Responder, 0, len(incident.IncidentResponders))
+	for _, r := range incident.IncidentResponders {
+		responders = append(responders, Responder{
+			Name:  r.User.Summary,
+			State: r.State,
+		})
+	}
+
+	return responders, nil
+}
+
+// maxLogEntries caps how many log entries are fetched per incident (API maximum per page)
+const maxLogEntries = 100
+
+// GetIncidentLogEntries fetches the most recent log entries for a specific
+// incident through queue
+func (c *Client) GetIncidentLogEntries(incidentID string) ([]LogEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	result, err := c.queueRequest("ListIncidentLogEntries", ctx, incidentID, priorityNormal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch incident log entries: %w", err)
+	}
+
+	resp, ok := result.(*pagerduty.ListIncidentLogEntriesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type for log entries")
+	}
+
+	entries := make([]LogEntry, 0, len(resp.LogEntries))
+	for _, entry := range resp.LogEntries {
+		entries = append(entries, LogEntry{
+			ID:        entry.ID,
+			Type:      entry.Type,
+			Summary:   entry.Summary,
+			CreatedAt: entry.CreatedAt,
+			Agent:     entry.Agent.Summary,
+		})
+	}
+
+	return entries, nil
+}
+
+// ListPriorities fetches the account's incident priorities through queue
+func (c *Client) ListPriorities() ([]Priority, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	result, err := c.queueRequest("ListPriorities", ctx, nil, priorityNormal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch priorities: %w", err)
+	}
+
+	resp, ok := result.(*pagerduty.ListPrioritiesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type for priorities")
+	}
+
+	priorities := make([]Priority, 0, len(resp.Priorities))
+	for _, p := range resp.Priorities {
+		priorities = append(priorities, Priority{
+			ID:          p.ID,
+			Name:        p.Name,
+			Description: p.Description,
+		})
+	}
+
+	return priorities, nil
+}
+
+// maxUserPages caps ListUsers pagination (100 users per page). Hitting the cap
+// is logged so a truncated user list isn't silent.
+const maxUserPages = 10
+
+// ListUsers fetches users whose name or email matches query, or every user
+// when query is empty, through queue
+func (c *Client) ListUsers(query string) ([]UserSummary, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeoutFor(nil))
+	defer cancel()
+
+	users := []UserSummary{}
+	opts := pagerduty.ListUsersOptions{
+		Query: query,
+		Limit: 100,
+	}
+
+	for page := 0; page < maxUserPages; page++ {
+		opts.Offset = uint(page) * opts.Limit
+
+		result, err := c.queueRequest("ListUsers", ctx, opts, priorityNormal)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch users: %w", err)
+		}
+
+		resp, ok := result.(*pagerduty.ListUsersResponse)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response type for users")
+		}
+
+		for _, u := range resp.Users {
+			name := u.Name
+			if name == "" {
+				name = u.Summary
+			}
+			users = append(users, UserSummary{
+				ID:    u.ID,
+				Name:  name,
+				Email: u.Email,
+			})
+		}
+
+		if !resp.More || len(resp.Users) == 0 {
+			break
+		}
+		if page == maxUserPages-1 {
+			c.logger(fmt.Sprintf("ListUsers stopped after %d pages with more users left; returning the first %d", maxUserPages, len(users)))
+		}
+	}
+
+	return users, nil
+}
+
+// maxTeamPages caps how many pages of teams ListTeams fetches
+const maxTeamPages = 10
+
+// ListTeams fetches every team in the account through queue
+func (c *Client) ListTeams() ([]Team, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeoutFor(nil))
+	defer cancel()
+
+	teams := []Team{}
+	opts := pagerduty.ListTeamOptions{Limit: 100}
+
+	for page := 0; page < maxTeamPages; page++ {
+		opts.Offset = uint(page) * opts.Limit
+
+		result, err := c.queueRequest("ListTeams", ctx, opts, priorityNormal)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch teams: %w", err)
+		}
+
+		resp, ok := result.(*pagerduty.ListTeamResponse)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response type for teams")
+		}
+
+		for _, t := range resp.Teams {
+			teams = append(teams, Team{ID: t.ID, Name: t.Name})
+		}
+
+		if !resp.More || len(resp.Teams) == 0 {
+			break
+		}
+	}
+
+	return teams, nil
+}
+
+// GetOnCallsForService returns who is currently on call for the service's
+// escalation policy, ordered by escalation level, through queue
+func (c *Client) GetOnCallsForService(serviceID string) ([]OnCallEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Resolve the service's escalation policy
+	result, err := c.queueRequest("GetService", ctx, serviceID, priorityNormal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch service %s: %w", serviceID, err)
+	}
+
+	service, ok := result.(*pagerduty.Service)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type for service")
+	}
+
+	policyID := service.EscalationPolicy.ID
+	if policyID == "" {
+		return nil, fmt.Errorf("service %s has no escalation policy", serviceID)
+	}
+
+	opts := pagerduty.ListOnCallOptions{
+		EscalationPolicyIDs: []string{policyID},
+		Includes:            []string{"users", "schedules"},
+	}
+
+	result, err = c.queueRequest("ListOnCalls", ctx, opts, priorityNormal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch on-calls: %w", err)
+	}
+
+	resp, ok := result.(*pagerduty.ListOnCallsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type for on-calls")
+	}
+
+	entries := make([]OnCallEntry, 0, len(resp.OnCalls))
+	for _, oc := range resp.OnCalls {
+		entry := OnCallEntry{
+			UserID:          oc.User.ID,
+			UserName:        oc.User.Name,
+			EscalationLevel: oc.EscalationLevel,
+			ScheduleName:    oc.Schedule.Name,
+			Start:           oc.Start,
+			End:             oc.End,
+		}
+		// Fall back to reference summaries if includes were not expanded
+		if entry.UserName == "" {
+			entry.UserName = oc.User.Summary
+		}
+		if entry.ScheduleName == "" {
+			entry.ScheduleName = oc.Schedule.Summary
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].EscalationLevel < entries[j].EscalationLevel
+	})
+
+	return entries, nil
+}
+
 // Helper function to safely get string from interface
 func getString(m map[string]interface{}, key string) string {
 	if val, ok := m[key]; ok {
@@ -746,12 +1310,88 @@ func extractDescription(details interface{}) string {
 	return ""
 }
 
-// GetAPIStats returns current API queue statistics
-func (c *Client) GetAPIStats() (totalCalls int64, failedCalls int64, pendingRequests int) {
+// urlPattern matches http(s) URLs inside alert body strings
+var urlPattern = regexp.MustCompile(`https?://[^\s"'<>]+`)
+
+// maxBodyLinks caps how many links are taken from a single alert body
+const maxBodyLinks = 10
+
+// genericLinkKeys are body keys too vague to label a link with; the URL's
+// host is used instead
+var genericLinkKeys = map[string]bool{"href": true, "url": true, "link": true, "links": true}
+
+// extractBodyLinks walks an alert body for strings containing URLs and returns
+// them as links labeled after the key they were found under. Links already in
+// existing are skipped. Map keys are visited in sorted order so the result is
+// stable between fetches.
+func extractBodyLinks(body map[string]interface{}, existing []AlertLink) []AlertLink {
+	seen := make(map[string]bool, len(existing))
+	for _, link := range existing {
+		seen[link.Href] = true
+	}
+
+	var links []AlertLink
+	var walk func(key string, value interface{})
+	walk = func(key string, value interface{}) {
+		if len(links) >= maxBodyLinks {
+			return
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for k := range v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				walk(k, v[k])
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(key, item)
+			}
+		case string:
+			for _, href := range urlPattern.FindAllString(v, -1) {
+				href = strings.TrimRight(href, ".,;:)]}")
+				if seen[href] || len(links) >= maxBodyLinks {
+					continue
+				}
+				seen[href] = true
+				links = append(links, AlertLink{Href: href, Text: linkLabel(key, href)})
+			}
+		}
+	}
+	walk("", body)
+
+	return links
+}
+
+// linkLabel derives a readable label for a link from the body key it was
+// found under (e.g. "dashboard_url" -> "dashboard url"), falling back to the
+// URL's host
+func linkLabel(key, href string) string {
+	if key != "" && !genericLinkKeys[strings.ToLower(key)] {
+		label := strings.NewReplacer("_", " ", "-", " ").Replace(key)
+		if label = strings.TrimSpace(label); label != "" {
+			return label
+		}
+	}
+
+	if u, err := url.Parse(href); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return href
+}
+
+// GetAPIStats returns current API queue statistics, including whether the
+// queue is paused after a 429 response
+func (c *Client) GetAPIStats() (totalCalls int64, failedCalls int64, pendingRequests int, rateLimited bool) {
 	c.apiQueue.metricsmu.RLock()
 	defer c.apiQueue.metricsmu.RUnlock()
 
 	return atomic.LoadInt64(&c.apiQueue.totalCalls),
 		atomic.LoadInt64(&c.apiQueue.failedCalls),
-		len(c.apiQueue.requestChan)
+		len(c.apiQueue.requestChan) + len(c.apiQueue.highPriorityChan),
+		c.IsRateLimited()
 }
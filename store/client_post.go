@@ -2,11 +2,18 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
 )
 
+// MaxSnoozeDuration is the longest snooze PagerDuty accepts for an incident.
+const MaxSnoozeDuration = 7 * 24 * time.Hour
+
 // AcknowledgeIncident acknowledges an incident through the queue
 func (c *Client) AcknowledgeIncident(incidentID, userEmail string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -18,7 +25,7 @@ func (c *Client) AcknowledgeIncident(incidentID, userEmail string) error {
 		Status:     "acknowledged",
 	}
 
-	result, err := c.queueRequest("ManageIncidents", ctx, opts)
+	result, err := c.queueRequest("ManageIncidents", ctx, opts, priorityHigh)
 	if err != nil {
 		return fmt.Errorf("failed to acknowledge incident: %w", err)
 	}
@@ -42,7 +49,7 @@ func (c *Client) ResolveIncident(incidentID, userEmail string) error {
 		Status:     "resolved",
 	}
 
-	result, err := c.queueRequest("ManageIncidents", ctx, opts)
+	result, err := c.queueRequest("ManageIncidents", ctx, opts, priorityHigh)
 	if err != nil {
 		return fmt.Errorf("failed to resolve incident: %w", err)
 	}
@@ -55,6 +62,131 @@ func (c *Client) ResolveIncident(incidentID, userEmail string) error {
 	return fmt.Errorf("unexpected response from resolve incident")
 }
 
+// ReopenIncident sets a resolved incident back to triggered through the queue.
+// PagerDuty only allows this for a limited time after resolution.
+func (c *Client) ReopenIncident(incidentID, userEmail string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	opts := ManageIncidentsRequest{
+		From:       userEmail,
+		IncidentID: incidentID,
+		Status:     "triggered",
+	}
+
+	result, err := c.queueRequest("ManageIncidents", ctx, opts, priorityHigh)
+	if err != nil {
+		var apiErr pagerduty.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode >= http.StatusBadRequest && apiErr.StatusCode < http.StatusInternalServerError && !apiErr.RateLimited() {
+			return fmt.Errorf("PagerDuty rejected the reopen; the incident may have been resolved too long ago: %w", err)
+		}
+		return fmt.Errorf("failed to reopen incident: %w", err)
+	}
+
+	// Check if the response indicates success
+	if result != nil {
+		return nil
+	}
+
+	return fmt.Errorf("unexpected response from reopen incident")
+}
+
+// SetIncidentPriority sets the priority of an incident through the queue
+func (c *Client) SetIncidentPriority(incidentID, priorityID, userEmail string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	opts := ManageIncidentsRequest{
+		From:       userEmail,
+		IncidentID: incidentID,
+		PriorityID: priorityID,
+	}
+
+	result, err := c.queueRequest("ManageIncidents", ctx, opts, priorityHigh)
+	if err != nil {
+		return fmt.Errorf("failed to set incident priority: %w", err)
+	}
+
+	// Check if the response indicates success
+	if result != nil {
+		return nil
+	}
+
+	return fmt.Errorf("unexpected response from set incident priority")
+}
+
+// SnoozeIncident snoozes an acknowledged incident through the queue.
+// Durations above MaxSnoozeDuration are capped to avoid API rejections.
+func (c *Client) SnoozeIncident(incidentID string, duration time.Duration, userEmail string) error {
+	if duration <= 0 {
+		return fmt.Errorf("snooze duration must be positive")
+	}
+	if duration > MaxSnoozeDuration {
+		duration = MaxSnoozeDuration
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	opts := SnoozeIncidentRequest{
+		From:            userEmail,
+		IncidentID:      incidentID,
+		DurationSeconds: uint(duration / time.Second),
+	}
+
+	result, err := c.queueRequest("SnoozeIncident", ctx, opts, priorityHigh)
+	if err != nil {
+		return fmt.Errorf("failed to snooze incident: %w", err)
+	}
+
+	// Check if the response indicates success
+	if result != nil {
+		return nil
+	}
+
+	return fmt.Errorf("unexpected response from snooze incident")
+}
+
+// postIncidentSnooze calls POST /incidents/{id}/snooze. go-pagerduty's snooze
+// helper does not send the "From" header PagerDuty requires, so it is called directly.
+func (c *Client) postIncidentSnooze(ctx context.Context, req SnoozeIncidentRequest) ([]byte, error) {
+	body := map[string]uint{"duration": req.DurationSeconds}
+	headers := map[string]string{"From": req.From}
+
+	return c.pdRequest(ctx, "POST", fmt.Sprintf("/incidents/%s/snooze", req.IncidentID), body, headers)
+}
+
+// AddResponders requests additional responders on an incident through the
+// queue. Unlike reassignment, existing assignees are left in place.
+func (c *Client) AddResponders(incidentID string, userIDs []string, message, requesterID, userEmail string) error {
+	if len(userIDs) == 0 {
+		return fmt.Errorf("at least one responder is required")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	opts := ResponderRequest{
+		From:        userEmail,
+		IncidentID:  incidentID,
+		RequesterID: requesterID,
+		Message:     message,
+		UserIDs:     userIDs,
+	}
+
+	result, err := c.queueRequest("ResponderRequest", ctx, opts, priorityHigh)
+	if err != nil {
+		return fmt.Errorf("failed to add responders: %w", err)
+	}
+
+	// Check if the response indicates success
+	if result != nil {
+		return nil
+	}
+
+	return fmt.Errorf("unexpected response from add responders")
+}
+
 // CreateIncidentNote creates a note on an incident through the queue
 func (c *Client) CreateIncidentNote(incidentID string, noteContent string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -65,7 +197,7 @@ func (c *Client) CreateIncidentNote(incidentID string, noteContent string) error
 		Content:    noteContent,
 	}
 
-	result, err := c.queueRequest("CreateIncidentNote", ctx, opts)
+	result, err := c.queueRequest("CreateIncidentNote", ctx, opts, priorityHigh)
 	if err != nil {
 		return fmt.Errorf("failed to create incident note: %w", err)
 	}
@@ -83,6 +215,23 @@ type ManageIncidentsRequest struct {
 	From       string
 	IncidentID string
 	Status     string
+	PriorityID string // optional; leaves priority untouched when empty
+}
+
+// SnoozeIncidentRequest represents options for snoozing an incident
+type SnoozeIncidentRequest struct {
+	From            string
+	IncidentID      string
+	DurationSeconds uint
+}
+
+// ResponderRequest represents options for requesting responders on an incident
+type ResponderRequest struct {
+	From        string
+	IncidentID  string
+	RequesterID string
+	Message     string
+	UserIDs     []string
 }
 
 // CreateIncidentNoteRequest represents options for creating a note
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/PagerDuty/go-pagerduty"
+)
+
+func TestAcknowledgeIncidentThroughQueue(t *testing.T) {
+	type request struct {
+		method string
+		path   string
+		from   string
+		body   []byte
+	}
+	requests := make(chan request, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- request{method: r.Method, path: r.URL.Path, from: r.Header.Get("From"), body: body}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"incidents":[{"id":"PINC1","type":"incident","status":"acknowledged"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := newClient("test-token", pagerduty.WithAPIEndpoint(server.URL))
+	if err != nil {
+		t.Fatalf("newClient: %v", err)
+	}
+	defer client.Shutdown()
+
+	if err := client.AcknowledgeIncident("PINC1", "oncall@example.com"); err != nil {
+		t.Fatalf("AcknowledgeIncident: %v", err)
+	}
+
+	var got request
+	select {
+	case got = <-requests:
+	default:
+		t.Fatal("no request reached the API stub")
+	}
+
+	if got.method != http.MethodPut || got.path != "/incidents" {
+		t.Errorf("request = %s %s, want PUT /incidents", got.method, got.path)
+	}
+	if got.from != "oncall@example.com" {
+		t.Errorf("From header = %q, want %q", got.from, "oncall@example.com")
+	}
+
+	var payload struct {
+		Incidents []struct {
+			ID     string `json:"id"`
+			Type   string `json:"type"`
+			Status string `json:"status"`
+		} `json:"incidents"`
+	}
+	if err := json.Unmarshal(got.body, &payload); err != nil {
+		t.Fatalf("decode request body %q: %v", got.body, err)
+	}
+	if len(payload.Incidents) != 1 {
+		t.Fatalf("body has %d incidents, want 1: %s", len(payload.Incidents), got.body)
+	}
+	incident := payload.Incidents[0]
+	if incident.ID != "PINC1" || incident.Type != "incident" || incident.Status != "acknowledged" {
+		t.Errorf("body incident = %+v, want PINC1/incident/acknowledged", incident)
+	}
+}
@@ -88,7 +88,7 @@ func (c *Client) pdRequest(ctx context.Context, method, path string, body interf
 		}
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
@@ -232,7 +232,7 @@ func (c *Client) GetIncidentCustomFields(incidentID string) ([]CustomField, erro
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	result, err := c.queueRequest("GetCustomFields", ctx, incidentID)
+	result, err := c.queueRequest("GetCustomFields", ctx, incidentID, priorityNormal)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch incident custom fields: %w", err)
 	}
@@ -251,7 +251,7 @@ func (c *Client) GetIncidentCustomFieldValues(incidentID string) ([]CustomFieldV
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	result, err := c.queueRequest("GetCustomFieldValues", ctx, incidentID)
+	result, err := c.queueRequest("GetCustomFieldValues", ctx, incidentID, priorityNormal)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch incident custom field values: %w", err)
 	}
@@ -277,7 +277,7 @@ func (c *Client) SetIncidentCustomFieldValue(incidentID, fieldID string, value i
 		From:       fromEmail,
 	}
 
-	_, err := c.queueRequest("SetCustomFieldValue", ctx, opts)
+	_, err := c.queueRequest("SetCustomFieldValue", ctx, opts, priorityHigh)
 	if err != nil {
 		return fmt.Errorf("failed to set custom field value: %w", err)
 	}
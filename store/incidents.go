@@ -2,8 +2,10 @@ package store
 
 import (
 	"pager-ops/database"
+	"sort"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/PagerDuty/go-pagerduty"
 )
@@ -46,6 +48,26 @@ func convertToIncidentData(
 	}
 	acknowledgedBy := strings.Join(ackNames, ", ")
 
+	// Collect the names of everyone currently assigned.
+	assigneeNames := make([]string, 0, len(i.Assignments))
+	for _, assignment := range i.Assignments {
+		name := assignment.Assignee.Summary
+		if name != "" {
+			assigneeNames = append(assigneeNames, name)
+		}
+	}
+
+	// Priority is optional; accounts without priorities enabled leave it nil
+	priorityID := ""
+	priorityName := ""
+	if i.Priority != nil {
+		priorityID = i.Priority.ID
+		priorityName = i.Priority.Name
+		if priorityName == "" {
+			priorityName = i.Priority.Summary
+		}
+	}
+
 	return database.IncidentData{
 		IncidentID:     i.ID,
 		IncidentNumber: incidentNum,
@@ -59,7 +81,91 @@ func convertToIncidentData(
 		AlertCount:     alertCount,
 		Urgency:        urgency,
 		AcknowledgedBy: acknowledgedBy,
+		PriorityID:     priorityID,
+		PriorityName:   priorityName,
+		Assignees:      strings.Join(assigneeNames, ", "),
+	}
+}
+
+// normalizeAlertSummary reduces a summary to a grouping key: case and
+// whitespace are folded and digit runs collapse to "#", so alerts differing
+// only in counters, hosts like web-12 or timestamps group together
+func normalizeAlertSummary(summary string) string {
+	var b strings.Builder
+	inDigits := false
+	for _, r := range strings.ToLower(strings.Join(strings.Fields(summary), " ")) {
+		if unicode.IsDigit(r) {
+			if !inDigits {
+				b.WriteRune('#')
+			}
+			inDigits = true
+			continue
+		}
+		inDigits = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// alertTime parses an alert's created_at, returning the zero time if unset
+func alertTime(alert IncidentAlert) time.Time {
+	t, _ := time.Parse(time.RFC3339, alert.CreatedAt)
+	return t
+}
+
+// GroupAlerts groups alerts by normalized summary. Groups are ordered by size,
+// then by most recent alert.
+func GroupAlerts(alerts []IncidentAlert) []GroupedAlert {
+	sorted := append([]IncidentAlert{}, alerts...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return alertTime(sorted[i]).After(alertTime(sorted[j]))
+	})
+
+	index := make(map[string]int)
+	groups := []GroupedAlert{}
+	for _, alert := range sorted {
+		key := normalizeAlertSummary(alert.Summary)
+		i, ok := index[key]
+		if !ok {
+			// Alerts are newest first, so the first one seen sets the summary
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, GroupedAlert{
+				Summary:         alert.Summary,
+				LatestCreatedAt: alert.CreatedAt,
+				Statuses:        []string{},
+				Alerts:          []IncidentAlert{},
+			})
+		}
+
+		group := &groups[i]
+		group.Count++
+		group.Alerts = append(group.Alerts, alert)
+		if alert.Status != "" && !containsString(group.Statuses, alert.Status) {
+			group.Statuses = append(group.Statuses, alert.Status)
+		}
+	}
+
+	for i := range groups {
+		sort.Strings(groups[i].Statuses)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return alertTime(groups[i].Alerts[0]).After(alertTime(groups[j].Alerts[0]))
+	})
+
+	return groups
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
 	}
+	return false
 }
 
 func deduplicateIncidents(
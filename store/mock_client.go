@@ -0,0 +1,489 @@
+package store
+
+import (
+	"fmt"
+	"math/rand"
+	"pager-ops/database"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+)
+
+// Demo data used by MockClient
+var (
+	mockServices = []struct{ ID, Name string }{
+		{"PDEMO01", "Checkout API"},
+		{"PDEMO02", "Payments Worker"},
+		{"PDEMO03", "Search Cluster"},
+	}
+	mockTitles = []string{
+		"High error rate on /checkout",
+		"Queue depth above threshold",
+		"p99 latency above 2s",
+		"Disk usage above 90%",
+		"Health check failing",
+		"Certificate expires in 7 days",
+	}
+	mockPriorities = []Priority{
+		{ID: "PDEMOP1", Name: "P1", Description: "Critical"},
+		{ID: "PDEMOP2", Name: "P2", Description: "High"},
+		{ID: "PDEMOP3", Name: "P3", Description: "Moderate"},
+		{ID: "PDEMOP4", Name: "P4", Description: "Low"},
+	}
+)
+
+// Both clients must satisfy the interface the app depends on
+var (
+	_ PagerDutyClient = (*Client)(nil)
+	_ PagerDutyClient = (*MockClient)(nil)
+)
+
+// mockIncidentInterval is how often a new synthetic incident is generated
+const mockIncidentInterval = 45 * time.Second
+
+// MockClient returns synthetic data for demo mode without calling PagerDuty.
+// New incidents are generated as the app polls, and older ones progress from
+// triggered to acknowledged to resolved.
+type MockClient struct {
+	mu          sync.Mutex
+	incidents   map[string]database.IncidentData
+	notes       map[string][]IncidentNote
+	responders  map[string][]Responder
+	assigned    map[string]bool
+	nextNumber  int
+	lastCreated time.Time
+	rng         *rand.Rand
+	logger      func(string)
+	maxCalls    int
+}
+
+// NewMockClient creates a demo client seeded with a few incidents
+func NewMockClient() *MockClient {
+	m := &MockClient{
+		incidents:  make(map[string]database.IncidentData),
+		notes:      make(map[string][]IncidentNote),
+		responders: make(map[string][]Responder),
+		assigned:   make(map[string]bool),
+		nextNumber: 1000,
+		rng:        rand.New(rand.NewSource(time.Now().UnixNano())),
+		logger:     func(msg string) { fmt.Println(msg) },
+		maxCalls:   600,
+	}
+
+	now := time.Now()
+	for i := 0; i < 4; i++ {
+		m.createIncident(now.Add(-time.Duration(i*20) * time.Minute))
+	}
+	for i := 0; i < 6; i++ {
+		incident := m.createIncident(now.Add(-time.Duration(2+i*5) * time.Hour))
+		incident.Status = "resolved"
+		incident.UpdatedAt = incident.CreatedAt.Add(time.Duration(10+m.rng.Intn(50)) * time.Minute)
+		m.incidents[incident.IncidentID] = incident
+	}
+	m.lastCreated = now
+
+	return m
+}
+
+// createIncident adds a new triggered incident. Caller must hold mu (or be the constructor).
+func (m *MockClient) createIncident(createdAt time.Time) database.IncidentData {
+	m.nextNumber++
+	service := mockServices[m.rng.Intn(len(mockServices))]
+	urgency := "high"
+	if m.rng.Intn(3) == 0 {
+		urgency = "low"
+	}
+
+	incident := database.IncidentData{
+		IncidentID:     fmt.Sprintf("PDEMOINC%d", m.nextNumber),
+		IncidentNumber: m.nextNumber,
+		Title:          mockTitles[m.rng.Intn(len(mockTitles))],
+		ServiceSummary: service.Name,
+		ServiceID:      service.ID,
+		Status:         "triggered",
+		HTMLURL:        fmt.Sprintf("https://demo.pagerduty.com/incidents/PDEMOINC%d", m.nextNumber),
+		CreatedAt:      createdAt,
+		UpdatedAt:      createdAt,
+		AlertCount:     1 + m.rng.Intn(3),
+		Urgency:        urgency,
+	}
+	if m.rng.Intn(2) == 0 {
+		m.assigned[incident.IncidentID] = true
+		incident.Assignees = "Demo User"
+	}
+	m.incidents[incident.IncidentID] = incident
+	return incident
+}
+
+// advance generates new incidents and moves existing ones along their lifecycle
+func (m *MockClient) advance() {
+	now := time.Now()
+	if now.Sub(m.lastCreated) < mockIncidentInterval {
+		return
+	}
+	m.lastCreated = now
+
+	for id, incident := range m.incidents {
+		switch {
+		case incident.Status == "acknowledged" && m.rng.Intn(3) == 0:
+			incident.Status = "resolved"
+			incident.UpdatedAt = now
+		case incident.Status == "triggered" && m.rng.Intn(2) == 0:
+			incident.Status = "acknowledged"
+			incident.AcknowledgedBy = "Demo User"
+			incident.UpdatedAt = now
+		default:
+			continue
+		}
+		m.incidents[id] = incident
+	}
+
+	m.createIncident(now)
+}
+
+// filter returns incidents matching the options, newest first
+func (m *MockClient) filter(opts FetchOptions) []database.IncidentData {
+	statuses := make(map[string]bool)
+	for _, s := range opts.Statuses {
+		statuses[s] = true
+	}
+	services := make(map[string]bool)
+	for _, s := range opts.ServiceIDs {
+		services[s] = true
+	}
+
+	var result []database.IncidentData
+	for _, incident := range m.incidents {
+		if len(statuses) > 0 && !statuses[incident.Status] {
+			continue
+		}
+		if len(services) > 0 && !services[incident.ServiceID] {
+			continue
+		}
+		if opts.UserID != "" && !m.assigned[incident.IncidentID] {
+			continue
+		}
+		if !opts.Since.IsZero() && incident.UpdatedAt.Before(opts.Since) {
+			continue
+		}
+		if !opts.Until.IsZero() && incident.UpdatedAt.After(opts.Until) {
+			continue
+		}
+		result = append(result, incident)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	if opts.Limit > 0 && uint(len(result)) > opts.Limit {
+		result = result[:opts.Limit]
+	}
+	return result
+}
+
+// setStatus updates an incident's status
+func (m *MockClient) setStatus(incidentID, status string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	incident, ok := m.incidents[incidentID]
+	if !ok {
+		return fmt.Errorf("incident not found: %s", incidentID)
+	}
+	incident.Status = status
+	incident.UpdatedAt = time.Now()
+	if status == "acknowledged" {
+		incident.AcknowledgedBy = "Demo User"
+	}
+	m.incidents[incidentID] = incident
+	return nil
+}
+
+func (m *MockClient) SetLogger(logger func(string)) {
+	m.logger = logger
+}
+
+func (m *MockClient) SetMaxCallsPerMinute(maxCalls int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.maxCalls = maxCalls
+}
+
+func (m *MockClient) MaxCallsPerMinute() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.maxCalls
+}
+
+func (m *MockClient) SetMaxOpenResults(maxResults int) {}
+
+func (m *MockClient) SetTimeouts(standard, resolved time.Duration) {}
+
+func (m *MockClient) Shutdown() {}
+
+func (m *MockClient) GetAPIStats() (totalCalls int64, failedCalls int64, pendingRequests int, rateLimited bool) {
+	return 0, 0, 0, false
+}
+
+func (m *MockClient) IsRateLimited() bool {
+	return false
+}
+
+func (m *MockClient) GetCurrentUser() (*pagerduty.User, error) {
+	return &pagerduty.User{
+		APIObject: pagerduty.APIObject{ID: "PDEMOUSER", Type: "user", Summary: "Demo User"},
+		Name:      "Demo User",
+		Email:     "demo@example.com",
+	}, nil
+}
+
+func (m *MockClient) FetchOpenIncidents(serviceIDs []string, userID string) ([]database.IncidentData, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.advance()
+
+	open := []string{"triggered", "acknowledged"}
+	if len(serviceIDs) > 0 && userID != "" {
+		return m.filter(FetchOptions{ServiceIDs: serviceIDs, UserID: userID, Statuses: open}), nil
+	}
+
+	var incidents []database.IncidentData
+	if len(serviceIDs) > 0 {
+		incidents = append(incidents, m.filter(FetchOptions{ServiceIDs: serviceIDs, Statuses: open})...)
+	}
+	if userID != "" {
+		incidents = append(incidents, m.filter(FetchOptions{UserID: userID, Statuses: open})...)
+	}
+	return deduplicateIncidents(incidents), nil
+}
+
+func (m *MockClient) FetchResolvedIncidents(serviceIDs []string) ([]database.IncidentData, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.filter(FetchOptions{ServiceIDs: serviceIDs, Statuses: []string{"resolved"}}), nil
+}
+
+func (m *MockClient) FetchIncidentsWithPagination(opts FetchOptions, pageSize uint) ([]database.IncidentData, error) {
+	return m.FetchIncidentsWithOptions(opts)
+}
+
+func (m *MockClient) FetchResolvedIncidentsConcurrent(serviceIDs []string, since, until time.Time, concurrency int) ([]database.IncidentData, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.filter(FetchOptions{ServiceIDs: serviceIDs, Statuses: []string{"resolved"}, Since: since, Until: until}), nil
+}
+
+func (m *MockClient) FetchIncidentsWithOptions(opts FetchOptions) ([]database.IncidentData, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.filter(opts), nil
+}
+
+func (m *MockClient) GetIncidentAlerts(incidentID string) ([]IncidentAlert, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	incident, ok := m.incidents[incidentID]
+	if !ok {
+		return nil, fmt.Errorf("incident not found: %s", incidentID)
+	}
+
+	alerts := make([]IncidentAlert, 0, incident.AlertCount)
+	for i := 0; i < incident.AlertCount; i++ {
+		alerts = append(alerts, IncidentAlert{
+			ID:          fmt.Sprintf("%s-A%d", incidentID, i+1),
+			Summary:     incident.Title,
+			Status:      incident.Status,
+			CreatedAt:   incident.CreatedAt.Add(time.Duration(i) * time.Minute).Format(time.RFC3339),
+			ServiceName: incident.ServiceSummary,
+			Description: "Synthetic alert generated in demo mode",
+			Links: []AlertLink{
+				{Href: "https://example.com/dashboard", Text: "Dashboard"},
+			},
+		})
+	}
+	return alerts, nil
+}
+
+func (m *MockClient) GetIncidentNotes(incidentID string) ([]IncidentNote, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]IncidentNote{}, m.notes[incidentID]...), nil
+}
+
+func (m *MockClient) GetIncidentResponders(incidentID string) ([]Responder, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Responder{}, m.responders[incidentID]...), nil
+}
+
+func (m *MockClient) GetIncidentLogEntries(incidentID string) ([]LogEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	incident, ok := m.incidents[incidentID]
+	if !ok {
+		return nil, fmt.Errorf("incident not found: %s", incidentID)
+	}
+
+	entries := []LogEntry{{
+		ID:        incidentID + "-L1",
+		Type:      "trigger_log_entry",
+		Summary:   "Triggered through the API",
+		CreatedAt: incident.CreatedAt.Format(time.RFC3339),
+		Agent:     incident.ServiceSummary,
+	}}
+	if incident.Status != "triggered" {
+		entries = append(entries, LogEntry{
+			ID:        incidentID + "-L2",
+			Type:      "acknowledge_log_entry",
+			Summary:   "Acknowledged by Demo User",
+			CreatedAt: incident.UpdatedAt.Format(time.RFC3339),
+			Agent:     "Demo User",
+		})
+	}
+	if incident.Status == "resolved" {
+		entries = append(entries, LogEntry{
+			ID:        incidentID + "-L3",
+			Type:      "resolve_log_entry",
+			Summary:   "Resolved by Demo User",
+			CreatedAt: incident.UpdatedAt.Format(time.RFC3339),
+			Agent:     "Demo User",
+		})
+	}
+	return entries, nil
+}
+
+func (m *MockClient) ListPriorities() ([]Priority, error) {
+	return append([]Priority{}, mockPriorities...), nil
+}
+
+// demoUsers is the directory returned by ListUsers in demo mode
+var demoUsers = []UserSummary{
+	{ID: "PDEMOUSER", Name: "Demo User", Email: "demo@example.com"},
+	{ID: "PDEMOALEX", Name: "Alex Rivera", Email: "alex@example.com"},
+	{ID: "PDEMOSAM", Name: "Sam Chen", Email: "sam@example.com"},
+}
+
+func (m *MockClient) ListUsers(query string) ([]UserSummary, error) {
+	query = strings.ToLower(query)
+	matches := []UserSummary{}
+	for _, u := range demoUsers {
+		if strings.Contains(strings.ToLower(u.Name), query) || strings.Contains(strings.ToLower(u.Email), query) {
+			matches = append(matches, u)
+		}
+	}
+	return matches, nil
+}
+
+func (m *MockClient) ListTeams() ([]Team, error) {
+	return []Team{
+		{ID: "PDEMOTEAM1", Name: "Platform"},
+		{ID: "PDEMOTEAM2", Name: "Payments"},
+	}, nil
+}
+
+func (m *MockClient) GetOnCallsForService(serviceID string) ([]OnCallEntry, error) {
+	return []OnCallEntry{
+		{UserID: "PDEMOUSER", UserName: "Demo User", EscalationLevel: 1, ScheduleName: "Primary"},
+		{UserID: "PDEMOUSER2", UserName: "Backup Engineer", EscalationLevel: 2, ScheduleName: "Secondary"},
+	}, nil
+}
+
+func (m *MockClient) AcknowledgeIncident(incidentID, userEmail string) error {
+	return m.setStatus(incidentID, "acknowledged")
+}
+
+func (m *MockClient) ResolveIncident(incidentID, userEmail string) error {
+	return m.setStatus(incidentID, "resolved")
+}
+
+func (m *MockClient) ReopenIncident(incidentID, userEmail string) error {
+	m.mu.Lock()
+	incident, ok := m.incidents[incidentID]
+	m.mu.Unlock()
+	if ok && incident.Status != "resolved" {
+		return fmt.Errorf("incident %s is not resolved", incidentID)
+	}
+	return m.setStatus(incidentID, "triggered")
+}
+
+func (m *MockClient) SetIncidentPriority(incidentID, priorityID, userEmail string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	incident, ok := m.incidents[incidentID]
+	if !ok {
+		return fmt.Errorf("incident not found: %s", incidentID)
+	}
+	for _, p := range mockPriorities {
+		if p.ID == priorityID {
+			incident.PriorityID = p.ID
+			incident.PriorityName = p.Name
+			incident.UpdatedAt = time.Now()
+			m.incidents[incidentID] = incident
+			return nil
+		}
+	}
+	return fmt.Errorf("priority not found: %s", priorityID)
+}
+
+func (m *MockClient) SnoozeIncident(incidentID string, duration time.Duration, userEmail string) error {
+	if duration <= 0 {
+		return fmt.Errorf("snooze duration must be positive")
+	}
+	return nil
+}
+
+func (m *MockClient) CreateIncidentNote(incidentID string, noteContent string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.incidents[incidentID]; !ok {
+		return fmt.Errorf("incident not found: %s", incidentID)
+	}
+	m.notes[incidentID] = append(m.notes[incidentID], IncidentNote{
+		ID:        fmt.Sprintf("%s-N%d", incidentID, len(m.notes[incidentID])+1),
+		Content:   noteContent,
+		CreatedAt: time.Now().Format(time.RFC3339),
+		UserName:  "Demo User",
+	})
+	return nil
+}
+
+func (m *MockClient) AddResponders(incidentID string, userIDs []string, message, requesterID, userEmail string) error {
+	if len(userIDs) == 0 {
+		return fmt.Errorf("at least one responder is required")
+	}
+
+	m.mu.Lock()
+	for _, userID := range userIDs {
+		name := userID
+		for _, u := range demoUsers {
+			if u.ID == userID {
+				name = u.Name
+				break
+			}
+		}
+		m.responders[incidentID] = append(m.responders[incidentID], Responder{Name: name, State: "pending"})
+	}
+	m.mu.Unlock()
+
+	return m.CreateIncidentNote(incidentID, fmt.Sprintf("Requested %d responder(s): %s", len(userIDs), message))
+}
+
+func (m *MockClient) GetIncidentCustomFields(incidentID string) ([]CustomField, error) {
+	return []CustomField{}, nil
+}
+
+func (m *MockClient) GetIncidentCustomFieldValues(incidentID string) ([]CustomFieldValue, error) {
+	return []CustomFieldValue{}, nil
+}
+
+func (m *MockClient) SetIncidentCustomFieldValue(incidentID, fieldID string, value interface{}, fromEmail string) error {
+	return nil
+}
@@ -0,0 +1,98 @@
+package store
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+)
+
+// Pause applied after a 429 when PagerDuty sends no usable Retry-After, and
+// the longest pause honored when it does
+const (
+	defaultRateLimitPause = 30 * time.Second
+	maxRateLimitPause     = 2 * time.Minute
+)
+
+// rateLimitHTTPClient wraps the HTTP client used for PagerDuty calls and
+// reports 429 responses with their requested wait. go-pagerduty's APIError
+// carries the status code but not the response headers, so Retry-After has
+// to be read here.
+type rateLimitHTTPClient struct {
+	next      pagerduty.HTTPClient
+	onLimited func(wait time.Duration)
+}
+
+func (h *rateLimitHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	resp, err := h.next.Do(req)
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		h.onLimited(retryAfter(resp.Header))
+	}
+	return resp, err
+}
+
+// retryAfter reads the wait requested by a 429 response: Retry-After as
+// seconds or an HTTP date, falling back to PagerDuty's ratelimit-reset
+func retryAfter(header http.Header) time.Duration {
+	for _, name := range []string{"Retry-After", "Ratelimit-Reset"} {
+		value := header.Get(name)
+		if value == "" {
+			continue
+		}
+		if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if t, err := http.ParseTime(value); err == nil {
+			if wait := time.Until(t); wait > 0 {
+				return wait
+			}
+		}
+	}
+	return defaultRateLimitPause
+}
+
+// markRateLimited pauses the queue for wait, never shortening a pause that is
+// already in effect
+func (c *Client) markRateLimited(wait time.Duration) {
+	if wait > maxRateLimitPause {
+		wait = maxRateLimitPause
+	}
+	until := time.Now().Add(wait).UnixNano()
+
+	for {
+		current := atomic.LoadInt64(&c.rateLimitedUntil)
+		if current >= until {
+			return
+		}
+		if atomic.CompareAndSwapInt64(&c.rateLimitedUntil, current, until) {
+			c.logger("PagerDuty rate limit hit (429), pausing API queue for " + wait.Round(time.Second).String())
+			return
+		}
+	}
+}
+
+// rateLimitPause returns how long the queue should still hold off after a 429
+func (c *Client) rateLimitPause() time.Duration {
+	until := atomic.LoadInt64(&c.rateLimitedUntil)
+	if until == 0 {
+		return 0
+	}
+	if wait := time.Until(time.Unix(0, until)); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// IsRateLimited reports whether the queue is paused after a 429 response
+func (c *Client) IsRateLimited() bool {
+	return c.rateLimitPause() > 0
+}
+
+// isRateLimitError reports whether err is a 429 from go-pagerduty
+func isRateLimitError(err error) bool {
+	var apiErr pagerduty.APIError
+	return errors.As(err, &apiErr) && apiErr.RateLimited()
+}
@@ -21,6 +21,9 @@ type ServiceConfig struct {
 	Name     string        `json:"name"`
 	Disabled bool          `json:"disabled,omitempty"` // Added to track disabled state
 	Types    *ServiceTypes `json:"types,omitempty"`    // Optional notekit configuration
+	// SuppressNotifications keeps the service's incidents listed but skips
+	// triggered notifications, e.g. during a maintenance window
+	SuppressNotifications bool `json:"suppress_notifications,omitempty"`
 }
 
 // ServicesConfig represents the overall services configuration
@@ -65,6 +68,16 @@ type IncidentAlert struct {
 	Links       []AlertLink `json:"links,omitempty"`
 }
 
+// GroupedAlert collapses alerts whose summaries match after normalization.
+// Alerts holds the raw alerts, newest first, so the UI can expand the group.
+type GroupedAlert struct {
+	Summary         string          `json:"summary"` // summary of the newest alert in the group
+	Count           int             `json:"count"`
+	LatestCreatedAt string          `json:"latest_created_at"`
+	Statuses        []string        `json:"statuses"`
+	Alerts          []IncidentAlert `json:"alerts"`
+}
+
 // AlertLink represents a link in an alert
 type AlertLink struct {
 	Href string `json:"href"`
@@ -83,11 +96,57 @@ type IncidentNote struct {
 	FreeformContent string         `json:"freeform_content,omitempty"` // Additional freeform text
 }
 
+// LogEntry represents a single event in an incident's timeline
+type LogEntry struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"` // e.g. trigger_log_entry, acknowledge_log_entry
+	Summary   string `json:"summary"`
+	CreatedAt string `json:"created_at"`
+	Agent     string `json:"agent,omitempty"` // Who or what performed the action
+}
+
 // IncidentSidebarData represents the complete sidebar data for an incident
 type IncidentSidebarData struct {
 	IncidentID string          `json:"incident_id"`
 	Alerts     []IncidentAlert `json:"alerts"`
 	Notes      []IncidentNote  `json:"notes"`
+	Responders []Responder     `json:"responders,omitempty"` // cached from the last successful responders fetch
 	Loading    bool            `json:"loading"`
 	Error      string          `json:"error,omitempty"`
-}
\ No newline at end of file
+}
+
+// Responder is a user asked to join an incident through a responder request
+type Responder struct {
+	Name  string `json:"name"`
+	State string `json:"state"` // pending, joined or declined
+}
+
+// OnCallEntry represents a user currently on call for a service's escalation policy
+type OnCallEntry struct {
+	UserID          string `json:"user_id"`
+	UserName        string `json:"user_name"`
+	EscalationLevel uint   `json:"escalation_level"`
+	ScheduleName    string `json:"schedule_name,omitempty"` // empty when the level targets a user directly
+	Start           string `json:"start,omitempty"`
+	End             string `json:"end,omitempty"`
+}
+
+// UserSummary is the subset of a PagerDuty user needed for assignee pickers
+type UserSummary struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// Team is a PagerDuty team, used to filter incidents for larger orgs
+type Team struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Priority represents a PagerDuty incident priority (e.g. P1-P4)
+type Priority struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,397 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"pager-ops/database"
+
+	"github.com/99designs/keyring"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// webhookPollInterval is the slowest service/user polling interval used while
+// the webhook receiver is running. Polling continues as a safety net for
+// missed deliveries.
+const webhookPollInterval = 60 * time.Second
+
+// maxWebhookBody caps the size of an accepted webhook payload
+const maxWebhookBody = 1 << 20
+
+// webhookSecretItem is the keyring key holding the webhook signing secret
+const webhookSecretItem = "pagerduty-webhook-secret"
+
+// webhookPayload is the subset of a PagerDuty V3 webhook used to update incidents
+type webhookPayload struct {
+	Event struct {
+		ID           string          `json:"id"`
+		EventType    string          `json:"event_type"`
+		ResourceType string          `json:"resource_type"`
+		OccurredAt   time.Time       `json:"occurred_at"`
+		Data         webhookIncident `json:"data"`
+	} `json:"event"`
+}
+
+// webhookIncident is the incident resource carried by incident.* webhook events
+type webhookIncident struct {
+	ID        string    `json:"id"`
+	Number    int       `json:"number"`
+	Title     string    `json:"title"`
+	Status    string    `json:"status"`
+	Urgency   string    `json:"urgency"`
+	HTMLURL   string    `json:"html_url"`
+	CreatedAt time.Time `json:"created_at"`
+	Service   struct {
+		ID      string `json:"id"`
+		Summary string `json:"summary"`
+	} `json:"service"`
+	Priority *struct {
+		ID      string `json:"id"`
+		Summary string `json:"summary"`
+	} `json:"priority"`
+}
+
+// StartWebhookServer starts a local receiver for PagerDuty V3 webhooks on the
+// given port. While it runs, service and user polling slow down to webhookPollInterval.
+// Without a signing secret it only listens on 127.0.0.1, since unsigned
+// payloads from the network could inject incidents and open URLs.
+func (a *App) StartWebhookServer(port int) error {
+	if port <= 0 || port > 65535 {
+		return fmt.Errorf("invalid port: %d", port)
+	}
+
+	if a.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	a.webhookMu.Lock()
+	defer a.webhookMu.Unlock()
+
+	if a.webhookServer != nil {
+		return fmt.Errorf("webhook server already running")
+	}
+
+	secret, err := a.webhookSecret()
+	if err != nil {
+		return fmt.Errorf("failed to read webhook secret: %w", err)
+	}
+
+	addr := fmt.Sprintf(":%d", port)
+	if secret == "" {
+		addr = fmt.Sprintf("127.0.0.1:%d", port)
+		a.logger.Warn("No webhook secret set, accepting webhooks from this machine only")
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", port, err)
+	}
+
+	exposed := int32(0)
+	if secret != "" {
+		exposed = 1
+	}
+	atomic.StoreInt32(&a.webhookExposed, exposed)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/webhook", a.handleWebhook)
+
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+	}
+	a.webhookServer = server
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			a.logger.Error(fmt.Sprintf("Webhook server stopped: %v", err))
+		}
+	}()
+
+	a.intervalsMu.Lock()
+	a.webhookActive = true
+	a.intervalsMu.Unlock()
+	a.resetPollTickers()
+
+	a.logger.Info(fmt.Sprintf("Webhook server listening on %s", addr))
+	return nil
+}
+
+// StopWebhookServer gracefully stops the webhook receiver and restores the
+// configured polling intervals. It is a no-op if the server is not running.
+func (a *App) StopWebhookServer() error {
+	a.webhookMu.Lock()
+	defer a.webhookMu.Unlock()
+
+	if a.webhookServer == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := a.webhookServer.Shutdown(ctx)
+	a.webhookServer = nil
+
+	a.intervalsMu.Lock()
+	a.webhookActive = false
+	a.intervalsMu.Unlock()
+	a.resetPollTickers()
+
+	if err != nil {
+		return fmt.Errorf("failed to stop webhook server: %w", err)
+	}
+
+	a.logger.Info("Webhook server stopped")
+	return nil
+}
+
+// IsWebhookServerRunning reports whether the webhook receiver is active
+func (a *App) IsWebhookServerRunning() bool {
+	a.webhookMu.Lock()
+	defer a.webhookMu.Unlock()
+	return a.webhookServer != nil
+}
+
+// SetWebhookSecret stores the signing secret used to verify webhook payloads
+// in the keyring. An empty secret disables verification, which is only
+// allowed while the receiver listens on loopback; restart it to change where
+// it listens.
+func (a *App) SetWebhookSecret(secret string) error {
+	if a.kr == nil {
+		return fmt.Errorf("keyring not available")
+	}
+
+	if secret == "" {
+		if err := a.kr.Remove(webhookSecretItem); err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
+			a.logger.Error(fmt.Sprintf("Failed to remove webhook secret from keyring: %v", err))
+			return fmt.Errorf("failed to remove webhook secret: %w", err)
+		}
+		a.logger.Info("Webhook secret cleared")
+		return nil
+	}
+
+	if err := a.kr.Set(keyring.Item{
+		Key:  webhookSecretItem,
+		Data: []byte(secret),
+	}); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to save webhook secret to keyring: %v", err))
+		return fmt.Errorf("failed to save webhook secret: %w", err)
+	}
+
+	a.logger.Info("Webhook secret updated")
+	return nil
+}
+
+// webhookSecret returns the webhook signing secret, or "" when none is set.
+// Without a keyring no secret can be stored, so there is none.
+func (a *App) webhookSecret() (string, error) {
+	if a.kr == nil {
+		return "", nil
+	}
+
+	item, err := a.kr.Get(webhookSecretItem)
+	if errors.Is(err, keyring.ErrKeyNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(item.Data), nil
+}
+
+// migrateWebhookSecret moves a webhook secret saved in plain text in app_state
+// by earlier versions into the keyring
+func (a *App) migrateWebhookSecret() {
+	if a.kr == nil || a.db == nil {
+		return
+	}
+
+	secret, err := a.db.GetState("webhook_secret")
+	if err != nil || secret == "" {
+		return
+	}
+
+	if err := a.SetWebhookSecret(secret); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to migrate webhook secret to keyring: %v", err))
+		return
+	}
+	if err := a.db.SetState("webhook_secret", ""); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to clear plain-text webhook secret: %v", err))
+		return
+	}
+	a.logger.Info("Moved webhook secret into the keyring")
+}
+
+// handleWebhook receives a PagerDuty V3 webhook and applies incident events to the local DB
+func (a *App) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	secret, err := a.webhookSecret()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to read webhook secret: %v", err))
+		http.Error(w, "failed to verify signature", http.StatusInternalServerError)
+		return
+	}
+
+	// A receiver started with a secret listens on every interface, so it
+	// keeps rejecting unsigned payloads even if the secret is later cleared
+	if secret == "" && atomic.LoadInt32(&a.webhookExposed) == 1 {
+		a.logger.Warn("Rejected webhook: no signing secret configured")
+		http.Error(w, "webhook secret not configured", http.StatusUnauthorized)
+		return
+	}
+
+	if secret != "" && !verifyWebhookSignature(body, r.Header.Get("X-PagerDuty-Signature"), secret) {
+		a.logger.Warn("Rejected webhook with invalid signature")
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		a.logger.Warn(fmt.Sprintf("Rejected malformed webhook: %v", err))
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	event := payload.Event
+	switch event.EventType {
+	case "incident.triggered", "incident.acknowledged", "incident.resolved":
+	default:
+		// Other event types are acknowledged but not applied
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if err := a.applyWebhookIncident(event.EventType, event.OccurredAt, event.Data); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to apply webhook event %s: %v", event.ID, err))
+		http.Error(w, "failed to apply event", http.StatusInternalServerError)
+		return
+	}
+
+	a.logger.Info(fmt.Sprintf("Applied webhook event %s for incident %s", event.EventType, event.Data.ID))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// applyWebhookIncident upserts the incident carried by a webhook event, keeping
+// fields the webhook doesn't include from any stored copy. Like polling,
+// events are ignored while paused, and new incidents from services that
+// aren't selected are skipped; incidents already stored are always updated.
+func (a *App) applyWebhookIncident(eventType string, occurredAt time.Time, data webhookIncident) error {
+	if data.ID == "" {
+		return fmt.Errorf("event has no incident ID")
+	}
+
+	if a.isPaused() {
+		a.logger.Debug(fmt.Sprintf("Paused, ignoring webhook for incident %s", data.ID))
+		return nil
+	}
+
+	incident, err := a.db.GetIncidentByID(data.ID)
+	if err != nil {
+		if !a.webhookServiceSelected(data.Service.ID) {
+			a.logger.Debug(fmt.Sprintf("Ignoring webhook for incident %s on unselected service %s", data.ID, data.Service.ID))
+			return nil
+		}
+		incident = database.IncidentData{IncidentID: data.ID}
+	}
+
+	status := data.Status
+	if status == "" {
+		status = strings.TrimPrefix(eventType, "incident.")
+	}
+
+	if data.Number != 0 {
+		incident.IncidentNumber = data.Number
+	}
+	if data.Title != "" {
+		incident.Title = data.Title
+	}
+	if data.Service.ID != "" {
+		incident.ServiceID = data.Service.ID
+	}
+	if data.Service.Summary != "" {
+		incident.ServiceSummary = data.Service.Summary
+	}
+	incident.Status = status
+	if data.HTMLURL != "" {
+		incident.HTMLURL = data.HTMLURL
+	}
+	if !data.CreatedAt.IsZero() {
+		incident.CreatedAt = data.CreatedAt
+	}
+	incident.UpdatedAt = occurredAt
+	if incident.UpdatedAt.IsZero() {
+		incident.UpdatedAt = time.Now()
+	}
+	if data.Urgency != "" {
+		incident.Urgency = data.Urgency
+	}
+	if data.Priority != nil {
+		incident.PriorityID = data.Priority.ID
+		incident.PriorityName = data.Priority.Summary
+	}
+
+	if err := a.db.UpsertIncident(incident); err != nil {
+		return err
+	}
+
+	if status == "triggered" {
+		go a.checkForTriggeredIncidents()
+	}
+	if status == "resolved" {
+		a.confirmResolvedIncidents([]database.IncidentData{incident})
+	}
+	runtime.EventsEmit(a.ctx, "incidents-updated", "both")
+	return nil
+}
+
+// webhookServiceSelected reports whether service polling covers serviceID,
+// i.e. "all services" mode is on or the service is selected
+func (a *App) webhookServiceSelected(serviceID string) bool {
+	if a.monitoringAll() {
+		return true
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return containsService(a.selectedServices, serviceID)
+}
+
+// verifyWebhookSignature checks the X-PagerDuty-Signature header, which holds
+// one or more comma-separated "v1=<hex HMAC-SHA256>" signatures of the body
+func verifyWebhookSignature(body []byte, header, secret string) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	expected := "v1=" + hex.EncodeToString(mac.Sum(nil))
+
+	for _, signature := range strings.Split(header, ",") {
+		if hmac.Equal([]byte(strings.TrimSpace(signature)), []byte(expected)) {
+			return true
+		}
+	}
+	return false
+}